@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/jamestunnell/letter-boxed-solver/generating"
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+func generate(cmd *GenerateCmd) error {
+	seed := uint64(time.Now().UnixNano())
+	if cmd.Seed != nil {
+		seed = *cmd.Seed
+	}
+
+	opts := generating.Options{
+		Sides:            cmd.Sides,
+		LettersPerSide:   cmd.LettersPerSide,
+		MaxWords:         cmd.MaxWords,
+		MaxSolutionWords: cmd.SolutionWords,
+		MinVowels:        cmd.MinVowels,
+		MaxVowels:        cmd.MaxVowels,
+		MaxAttempts:      cmd.MaxAttempts,
+	}
+
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+
+	wordSource, closeWords, err := openWordSource(cmd.WordsCmd)
+	if err != nil {
+		return err
+	}
+
+	defer closeWords()
+
+	words := solving.ReadAllWords(wordSource)
+
+	log.Info().
+		Uint64("seed", seed).
+		Int("count", cmd.Count).
+		Int("words", len(words)).
+		Msg("generating puzzles")
+
+	g, err := generating.NewGenerator(opts, words, seed)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Outdir != stdoutName {
+		if err := makeOutdir(cmd.Outdir); err != nil {
+			return err
+		}
+	}
+
+	for i := range cmd.Count {
+		gen, err := g.Generate(context.Background())
+		if err != nil {
+			return err
+		}
+
+		log.Info().
+			Strs("sides", gen.Puzzle.GetSides()).
+			Strs("solution", gen.Solution).
+			Int("attempts", gen.Attempts).
+			Msg("generated puzzle")
+
+		if err = writeGenerated(gen, cmd.Outdir, fmt.Sprintf("generated-%d-%d", seed, i+1)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeGenerated(gen *generating.Generated, outdir, name string) error {
+	d, err := json.Marshal(gen.Puzzle)
+	if err != nil {
+		return fmt.Errorf("failed to marshal puzzle: %w", err)
+	}
+
+	if outdir == stdoutName {
+		fmt.Println(string(d))
+
+		return nil
+	}
+
+	outpath := path.Join(outdir, name+".json")
+
+	if err = os.WriteFile(outpath, d, 0640); err != nil {
+		return fmt.Errorf("failed to write puzzle file: %w", err)
+	}
+
+	log.Info().Str("outpath", outpath).Msg("wrote puzzle file")
+
+	return nil
+}
@@ -0,0 +1,250 @@
+package generating
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+type Options struct {
+	Sides          int
+	LettersPerSide int
+	MaxWords       int
+	// MaxSolutionWords requires a solution with at most this many words.
+	// Zero means any solution within MaxWords.
+	MaxSolutionWords int
+	MinVowels        int
+	MaxVowels        int
+	MaxAttempts      int
+}
+
+type Generator struct {
+	opts  Options
+	words []string
+	rand  *rand.Rand
+}
+
+// Generated is a generated puzzle along with a best solution, which proves
+// the puzzle can be solved.
+type Generated struct {
+	Puzzle   *models.Puzzle
+	Solution solving.Solution
+	Attempts int
+}
+
+const vowels = "AEIOU"
+
+// consonantWeights are rough English letter frequencies, so that common
+// consonants are picked more often than rare ones.
+var consonantWeights = map[rune]float64{
+	'B': 1.5, 'C': 2.8, 'D': 4.3, 'F': 2.2, 'G': 2.0, 'H': 6.1, 'J': 0.2,
+	'K': 0.8, 'L': 4.0, 'M': 2.4, 'N': 6.7, 'P': 1.9, 'Q': 0.1, 'R': 6.0,
+	'S': 6.3, 'T': 9.1, 'V': 1.0, 'W': 2.4, 'X': 0.2, 'Y': 2.0, 'Z': 0.1,
+}
+
+var (
+	errInvalidOptions = errors.New("invalid options")
+	errNotGenerated   = errors.New("failed to generate a solvable puzzle")
+)
+
+func DefaultOptions() Options {
+	return Options{
+		Sides:            4,
+		LettersPerSide:   3,
+		MaxWords:         4,
+		MaxSolutionWords: 0,
+		MinVowels:        3,
+		MaxVowels:        4,
+		MaxAttempts:      1000,
+	}
+}
+
+func NewGenerator(opts Options, words []string, seed uint64) (*Generator, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Generator{
+		opts:  opts,
+		words: words,
+		rand:  rand.New(rand.NewPCG(seed, seed)),
+	}, nil
+}
+
+func (opts Options) Validate() error {
+	numLetters := opts.Sides * opts.LettersPerSide
+
+	switch {
+	case opts.Sides < models.MinSides:
+		return fmt.Errorf("%w: sides %d < %d", errInvalidOptions, opts.Sides, models.MinSides)
+	case opts.LettersPerSide < 1:
+		return fmt.Errorf("%w: letters per side %d < 1", errInvalidOptions, opts.LettersPerSide)
+	case opts.MaxWords < 1:
+		return fmt.Errorf("%w: max words %d < 1", errInvalidOptions, opts.MaxWords)
+	case opts.MaxSolutionWords < 0 || opts.MaxSolutionWords > opts.MaxWords:
+		return fmt.Errorf(
+			"%w: max solution words %d is not in [0, %d]",
+			errInvalidOptions, opts.MaxSolutionWords, opts.MaxWords)
+	case opts.MinVowels < 0 || opts.MinVowels > opts.MaxVowels:
+		return fmt.Errorf(
+			"%w: vowel range [%d, %d] is invalid",
+			errInvalidOptions, opts.MinVowels, opts.MaxVowels)
+	case opts.MaxVowels > len(vowels):
+		return fmt.Errorf("%w: max vowels %d > %d", errInvalidOptions, opts.MaxVowels, len(vowels))
+	case numLetters-opts.MinVowels > len(consonantWeights):
+		return fmt.Errorf("%w: too many letters (%d)", errInvalidOptions, numLetters)
+	case opts.MaxAttempts < 1:
+		return fmt.Errorf("%w: max attempts %d < 1", errInvalidOptions, opts.MaxAttempts)
+	}
+
+	return nil
+}
+
+// Generate makes random puzzles until one can be solved within the max
+// solution words (or max words), or the max attempts are used up.
+func (g *Generator) Generate(ctx context.Context) (*Generated, error) {
+	for attempt := 1; attempt <= g.opts.MaxAttempts; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		p, err := models.NewPuzzle(g.randomSides(), g.opts.MaxWords)
+		if err != nil {
+			return nil, fmt.Errorf("generated invalid puzzle: %w", err)
+		}
+
+		sln, ok := g.bestSolution(ctx, p)
+		if !ok {
+			log.Debug().
+				Strs("sides", p.GetSides()).
+				Int("attempt", attempt).
+				Msg("generated puzzle is not solvable")
+
+			continue
+		}
+
+		return &Generated{Puzzle: p, Solution: sln, Attempts: attempt}, nil
+	}
+
+	return nil, fmt.Errorf("%w after %d attempts", errNotGenerated, g.opts.MaxAttempts)
+}
+
+func (g *Generator) bestSolution(ctx context.Context, p *models.Puzzle) (solving.Solution, bool) {
+	maxSolutionWords := g.opts.MaxWords
+	if g.opts.MaxSolutionWords > 0 {
+		maxSolutionWords = g.opts.MaxSolutionWords
+	}
+
+	// the shortest solutions are all that matter, so search with the
+	// tighter word limit
+	p.SetMaxWords(maxSolutionWords)
+
+	defer p.SetMaxWords(g.opts.MaxWords)
+
+	solver := solving.NewDPSolver(p, solving.NewSliceWordSource(g.words))
+
+	for !solver.IsFinished() && ctx.Err() == nil {
+		solver.Step(ctx)
+	}
+
+	solutions := solver.GetSolutions()
+	if len(solutions) == 0 {
+		return nil, false
+	}
+
+	best := solutions[0]
+	for _, sln := range solutions[1:] {
+		if sln.IsBetter(best) {
+			best = sln
+		}
+	}
+
+	return best, true
+}
+
+// randomSides picks distinct letters, with the number of vowels in the
+// configured range, and deals them out so that vowels are spread evenly
+// across the sides.
+func (g *Generator) randomSides() []string {
+	numLetters := g.opts.Sides * g.opts.LettersPerSide
+	numVowels := g.opts.MinVowels + g.rand.IntN(g.opts.MaxVowels-g.opts.MinVowels+1)
+	numVowels = min(numVowels, numLetters)
+
+	letters := g.pickVowels(numVowels)
+	letters = append(letters, g.pickConsonants(numLetters-numVowels)...)
+
+	// vowels come first, so dealing round-robin spreads them over the sides
+	sides := make([][]rune, g.opts.Sides)
+	for i, letter := range letters {
+		side := i % g.opts.Sides
+
+		sides[side] = append(sides[side], letter)
+	}
+
+	g.rand.Shuffle(len(sides), func(i, j int) {
+		sides[i], sides[j] = sides[j], sides[i]
+	})
+
+	strs := make([]string, len(sides))
+	for i, side := range sides {
+		g.rand.Shuffle(len(side), func(i, j int) {
+			side[i], side[j] = side[j], side[i]
+		})
+
+		strs[i] = string(side)
+	}
+
+	return strs
+}
+
+func (g *Generator) pickVowels(n int) []rune {
+	available := []rune(vowels)
+
+	g.rand.Shuffle(len(available), func(i, j int) {
+		available[i], available[j] = available[j], available[i]
+	})
+
+	return available[:n]
+}
+
+// pickConsonants does weighted sampling without replacement.
+func (g *Generator) pickConsonants(n int) []rune {
+	available := []rune{}
+	for r := range consonantWeights {
+		available = append(available, r)
+	}
+
+	// map order is random, so sort for reproducibility
+	slices.Sort(available)
+
+	picked := []rune{}
+
+	for range n {
+		total := 0.0
+		for _, r := range available {
+			total += consonantWeights[r]
+		}
+
+		x := g.rand.Float64() * total
+		i := 0
+
+		for ; i < len(available)-1; i++ {
+			x -= consonantWeights[available[i]]
+			if x < 0 {
+				break
+			}
+		}
+
+		picked = append(picked, available[i])
+		available = slices.Delete(available, i, i+1)
+	}
+
+	return picked
+}
@@ -0,0 +1,66 @@
+package generating_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jamestunnell/letter-boxed-solver/generating"
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+func loadWords(t *testing.T) []string {
+	f, err := os.Open("../words/scrabble-words.txt")
+
+	require.NoError(t, err)
+
+	defer f.Close()
+
+	return solving.ReadAllWords(solving.NewFileWordSource(f))
+}
+
+func TestGenerate(t *testing.T) {
+	words := loadWords(t)
+	opts := generating.DefaultOptions()
+
+	opts.MaxSolutionWords = 2
+
+	g1, err := generating.NewGenerator(opts, words, 7)
+
+	require.NoError(t, err)
+
+	g2, err := generating.NewGenerator(opts, words, 7)
+
+	require.NoError(t, err)
+
+	gen1, err := g1.Generate(context.Background())
+
+	require.NoError(t, err)
+
+	gen2, err := g2.Generate(context.Background())
+
+	require.NoError(t, err)
+
+	assert.Equal(t, gen1.Puzzle.GetSides(), gen2.Puzzle.GetSides())
+	assert.Equal(t, "square 4x3", gen1.Puzzle.Shape().String())
+	assert.Equal(t, 4, gen1.Puzzle.GetMaxWords())
+	assert.LessOrEqual(t, len(gen1.Solution), 2)
+	assert.True(t, gen1.Puzzle.DoLettersSolve(gen1.Solution.Letters()))
+}
+
+func TestOptionsInvalid(t *testing.T) {
+	opts := generating.DefaultOptions()
+
+	opts.MaxSolutionWords = opts.MaxWords + 1
+
+	assert.Error(t, opts.Validate())
+
+	opts = generating.DefaultOptions()
+	opts.MinVowels = 5
+	opts.MaxVowels = 6
+
+	assert.Error(t, opts.Validate())
+}
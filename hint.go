@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+func hint(cmd *HintCmd) error {
+	puzzle, err := loadPuzzleCmd(cmd.PuzzleCmd)
+	if err != nil {
+		return err
+	}
+
+	wordSource, closeWords, err := openWordSource(cmd.WordsCmd)
+	if err != nil {
+		return err
+	}
+
+	defer closeWords()
+
+	log.Info().
+		Strs("sides", puzzle.GetSides()).
+		Int("maxWords", puzzle.GetMaxWords()).
+		Strs("partial", cmd.Partial).
+		Int("level", cmd.Level).
+		Msg("finding hints")
+
+	hinter := solving.NewHinter(puzzle, solving.ReadAllWords(wordSource))
+
+	hints, err := hinter.Hints(context.Background(), cmd.Partial, solving.HintLevel(cmd.Level))
+	if err != nil {
+		return fmt.Errorf("failed to find hints: %w", err)
+	}
+
+	if cmd.JSON {
+		enc := json.NewEncoder(os.Stdout)
+
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(hints)
+	}
+
+	for _, h := range hints {
+		fmt.Printf("hint %d: %s\n", h.Level, h.Message)
+	}
+
+	return nil
+}
@@ -1,21 +1,28 @@
 package main
 
 import (
-	"bufio"
+	"context"
 	"embed"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"os"
 	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	arg "github.com/alexflint/go-arg"
+	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"golang.org/x/exp/maps"
 
 	"github.com/jamestunnell/letter-boxed-solver/models"
+	"github.com/jamestunnell/letter-boxed-solver/render"
 	"github.com/jamestunnell/letter-boxed-solver/solving"
 )
 
@@ -25,14 +32,85 @@ var puzzles embed.FS
 //go:embed words/*
 var words embed.FS
 
+//go:embed web/*
+var web embed.FS
+
 type ListBuiltinCmd struct {
+	Show bool `help:"draw each puzzle box"`
 }
 
 type SolveCmd struct {
 	MaxBranch int    `help:"max degree of a solving branch" default:"5"`
 	MaxTime   string `help:"max time to spend solving" default:"5s"`
+	Strategy  string `help:"solving strategy (best-first, exact, dp)" default:"best-first"`
+	Workers   int    `help:"number of explorers to run in parallel (best-first strategy)" default:"1"`
+	Scoring   string `help:"word scoring (uniform, weighted, inverse-frequency, entropy, hard-sides) used by the best-first strategy" default:"weighted"`
+	Progress  string `help:"interval between progress reports while solving (0 to disable)" default:"1s"`
+
+	WordsCmd
+	ConstraintsCmd
+	FilterCmd
+
+	Outdir string `arg:"-o" help:"output directory (created if it does not exist), or - for stdout" default:"."`
+	Format string `help:"solutions output format (text, json, csv)" default:"text"`
+	Show   bool   `help:"draw the best solution on the puzzle box when done"`
+	SVG    int    `arg:"--svg" help:"also write SVG images of the N best solutions to the output directory (or the current dir when writing to stdout)"`
+}
+
+var errNegativeSVG = errors.New("--svg cannot be negative")
+
+// check rejects output options that are not valid, before any solving.
+func (cmd SolveCmd) check() error {
+	if _, err := formatExt(cmd.Format); err != nil {
+		return err
+	}
+
+	if cmd.SVG < 0 {
+		return errNegativeSVG
+	}
 
-	Outdir string `arg:"-o" help:"output directory (created if it does not exist)" default:"."`
+	return nil
+}
+
+type WordsCmd struct {
+	Words     []string `arg:"--words,separate" help:"word list file, one word per line (repeatable, default is the built-in scrabble word list)"`
+	Blocklist []string `arg:"--blocklist,separate" help:"file of words to exclude, one word per line (repeatable)"`
+}
+
+// ConstraintsCmd limits the solutions searched for by the best-first
+// strategy.
+type ConstraintsCmd struct {
+	Require   []string `arg:"--require,separate" help:"only search for solutions that use this word (repeatable)"`
+	Forbid    []string `arg:"--forbid,separate" help:"only search for solutions that do not use this word (repeatable)"`
+	StartWord string   `help:"only search for solutions that start with this word"`
+	EndWord   string   `help:"only search for solutions that end with this word"`
+}
+
+func (cmd ConstraintsCmd) Constraints() solving.Constraints {
+	return solving.Constraints{
+		Required:  cmd.Require,
+		Forbidden: cmd.Forbid,
+		StartWord: cmd.StartWord,
+		EndWord:   cmd.EndWord,
+	}
+}
+
+// FilterCmd selects which of the solutions found are written out.
+type FilterCmd struct {
+	Top           int      `help:"only output the N best solutions that pass the other filters"`
+	MaxWordsOut   int      `arg:"--max-words-out" help:"only output solutions with at most this many words"`
+	MinTotalChars int      `arg:"--min-total-chars" help:"only output solutions with at least this many letters in total"`
+	MaxTotalChars int      `arg:"--max-total-chars" help:"only output solutions with at most this many letters in total"`
+	MustInclude   []string `arg:"--must-include,separate" help:"only output solutions that use this word (repeatable)"`
+	Exclude       []string `arg:"--exclude,separate" help:"only output solutions that do not use this word (repeatable)"`
+}
+
+// PuzzleCmd selects a puzzle for commands that work with one puzzle.
+type PuzzleCmd struct {
+	Builtin  string   `help:"name of a built-in puzzle file"`
+	File     string   `help:"path to a puzzle JSON file, or - for stdin"`
+	Sides    []string `help:"puzzle sides, with letters combined (e.g. abc def ghi jkl)"`
+	MaxWords int      `help:"max words to allow for puzzle solution (required with --sides, otherwise overrides the puzzle)"`
 }
 
 type SolveBuiltinCmd struct {
@@ -46,13 +124,88 @@ type SolveGivenCmd struct {
 	SolveCmd
 
 	MaxWords int      `arg:"required" help:"Max words to allow for puzzle solution"`
-	Sides    []string `arg:"required" help:"Puzzle sides, with letters combined (e.g. abc def ghi jkl)"`
+	Sides    []string `arg:"required" help:"Puzzle sides, with letters combined (e.g. abc def ghi jkl). Any number of sides (3+) and letters per side is allowed."`
+}
+
+type SolveFileCmd struct {
+	SolveCmd
+
+	MaxWords int    `help:"Max words to allow for puzzle solution. Overrides value defined by puzzle."`
+	Path     string `arg:"positional,required" help:"path to a puzzle JSON file, a directory of puzzle JSON files, or - for stdin"`
+}
+
+type GenerateCmd struct {
+	WordsCmd
+
+	Seed           *uint64 `help:"random seed, for reproducible puzzles (default is based on the current time)"`
+	Count          int     `help:"number of puzzles to generate" default:"1"`
+	Sides          int     `help:"number of sides" default:"4"`
+	LettersPerSide int     `help:"number of letters per side" default:"3"`
+	MaxWords       int     `help:"max words to allow for puzzle solution" default:"4"`
+	SolutionWords  int     `help:"require a solution with at most this many words (e.g. 2 for a 2-word solution, default is max words)"`
+	MinVowels      int     `help:"min number of vowels" default:"3"`
+	MaxVowels      int     `help:"max number of vowels" default:"4"`
+	MaxAttempts    int     `help:"max random puzzles to try for each generated puzzle" default:"1000"`
+
+	Outdir string `arg:"-o" help:"output directory (created if it does not exist), or - for stdout" default:"."`
+}
+
+type RateCmd struct {
+	PuzzleCmd
+	WordsCmd
+
+	CommonWords string `help:"file of common words, one word per line, for checking whether common-word solutions exist"`
+	JSON        bool   `help:"print the rating as JSON"`
+}
+
+type VerifyCmd struct {
+	PuzzleCmd
+	WordsCmd
+
+	JSON     bool     `help:"print the check result as JSON"`
+	Solution []string `arg:"positional,required" help:"solution words, in order"`
+}
+
+type HintCmd struct {
+	PuzzleCmd
+	WordsCmd
+
+	Level   int      `help:"how much to reveal: 1 = solution word count, 2 = next word's first letter, 3 = next word's length, 4 = next word" default:"1"`
+	JSON    bool     `help:"print the hints as JSON"`
+	Partial []string `arg:"positional" help:"solution words found so far, in order"`
+}
+
+type PlayCmd struct {
+	PuzzleCmd
+	WordsCmd
+
+	MaxTime string `help:"max time to spend finding hints or solutions" default:"10s"`
+}
+
+type ServeCmd struct {
+	WordsCmd
+
+	Dicts []string `arg:"--dict,separate" help:"another word list that requests can pick by name, given as name=path (repeatable)"`
+
+	Addr        string `help:"address to listen on" default:":8080"`
+	DefaultTime string `help:"time limit for solve requests that do not give one" default:"5s"`
+	MaxTime     string `help:"max time limit that solve requests can ask for" default:"30s"`
+	MaxBranch   int    `help:"max branching that solve requests can ask for" default:"20"`
+	MaxWorkers  int    `help:"max parallel explorers that solve requests can ask for" default:"4"`
+	Progress    string `help:"min interval between progress events when streaming solutions" default:"500ms"`
 }
 
 type Args struct {
 	ListBuiltin  *ListBuiltinCmd  `arg:"subcommand:list-builtin" help:"list built-in puzzle files"`
 	SolveBuiltin *SolveBuiltinCmd `arg:"subcommand:solve-builtin" help:"solve built-in puzzle file"`
 	SolveGiven   *SolveGivenCmd   `arg:"subcommand:solve-given" help:"solve given puzzle"`
+	SolveFile    *SolveFileCmd    `arg:"subcommand:solve-file" help:"solve puzzle JSON file(s) from a path or stdin"`
+	Generate     *GenerateCmd     `arg:"subcommand:generate" help:"generate random solvable puzzles"`
+	Rate         *RateCmd         `arg:"subcommand:rate" help:"rate puzzle difficulty"`
+	Verify       *VerifyCmd       `arg:"subcommand:verify" help:"check a solution against a puzzle"`
+	Hint         *HintCmd         `arg:"subcommand:hint" help:"get hints for a puzzle, optionally continuing a partial solution"`
+	Play         *PlayCmd         `arg:"subcommand:play" help:"play a puzzle interactively in the terminal"`
+	Serve        *ServeCmd        `arg:"subcommand:serve" help:"serve the HTTP JSON API"`
 }
 
 func (args Args) Version() string {
@@ -93,22 +246,35 @@ func main() {
 
 	switch {
 	case args.ListBuiltin != nil:
-		err = listBuiltin()
+		err = listBuiltin(args.ListBuiltin)
 	case args.SolveBuiltin != nil:
 		err = solveBuiltin(args.SolveBuiltin)
 	case args.SolveGiven != nil:
 		err = solveGiven(args.SolveGiven)
+	case args.SolveFile != nil:
+		err = solveFile(args.SolveFile)
+	case args.Generate != nil:
+		err = generate(args.Generate)
+	case args.Rate != nil:
+		err = rate(args.Rate)
+	case args.Verify != nil:
+		err = verify(args.Verify)
+	case args.Hint != nil:
+		err = hint(args.Hint)
+	case args.Play != nil:
+		err = play(args.Play)
+	case args.Serve != nil:
+		err = serve(args.Serve)
 	default:
 	}
 
 	if err != nil {
-		fmt.Printf("error: %v\n", err)
-
-		return
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
 	}
 }
 
-func listBuiltin() error {
+func listBuiltin(cmd *ListBuiltinCmd) error {
 	entries, err := puzzles.ReadDir("puzzles")
 	if err != nil {
 		return fmt.Errorf("failed to failed to read puzzle entries: %w", err)
@@ -118,6 +284,29 @@ func listBuiltin() error {
 
 	for _, entry := range entries {
 		fmt.Println(entry.Name())
+
+		if !cmd.Show {
+			continue
+		}
+
+		f, err := puzzles.Open("puzzles/" + entry.Name())
+		if err != nil {
+			return fmt.Errorf("failed to open built-in puzzle file: %w", err)
+		}
+
+		puzzle, err := loadPuzzle(f)
+
+		f.Close()
+
+		if err != nil {
+			return fmt.Errorf("failed to load puzzle %s: %w", entry.Name(), err)
+		}
+
+		if err := render.Text(os.Stdout, puzzle, nil, render.TextOptions{}); err != nil {
+			return fmt.Errorf("failed to draw puzzle %s: %w", entry.Name(), err)
+		}
+
+		fmt.Println()
 	}
 
 	return nil
@@ -126,7 +315,10 @@ func listBuiltin() error {
 func solveBuiltin(cmd *SolveBuiltinCmd) error {
 	fname := fmt.Sprintf("puzzles/%s", cmd.Fname)
 	name := strings.TrimSuffix(cmd.Fname, path.Ext(cmd.Fname))
-	outpath := fmt.Sprintf("%s/%s-solutions.txt", cmd.Outdir, name)
+
+	if err := cmd.check(); err != nil {
+		return err
+	}
 
 	maxTime, err := time.ParseDuration(cmd.MaxTime)
 	if err != nil {
@@ -153,56 +345,210 @@ func solveBuiltin(cmd *SolveBuiltinCmd) error {
 		Str("name", cmd.Fname).
 		Msg("loaded built-in puzzle")
 
-	solutions, err := solve(puzzle, maxTime, cmd.MaxBranch)
+	solutions, complete, err := solve(puzzle, maxTime, cmd.SolveCmd)
 	if err != nil {
 		return err
 	}
 
-	if err = reportSolutions(solutions, outpath); err != nil {
+	return reportSolutions(puzzle, cmd.SolveCmd, solutions, complete, name)
+}
+
+func solveGiven(cmd *SolveGivenCmd) error {
+	if err := cmd.check(); err != nil {
 		return err
 	}
 
-	return nil
-}
-
-var (
-	errNoSides            = errors.New("puzzle has no sides")
-	errMaxWordNotPositive = errors.New("max words is not > 0")
-)
+	p, err := models.NewPuzzle(cmd.Sides, cmd.MaxWords)
+	if err != nil {
+		return err
+	}
 
-func solveGiven(cmd *SolveGivenCmd) error {
-	if len(cmd.Sides) == 0 {
-		return errNoSides
+	maxTime, err := time.ParseDuration(cmd.MaxTime)
+	if err != nil {
+		return fmt.Errorf("error: failed to parse max time: %w", err)
 	}
 
-	if cmd.MaxWords < 1 {
-		return errMaxWordNotPositive
+	solutions, complete, err := solve(p, maxTime, cmd.SolveCmd)
+	if err != nil {
+		return err
 	}
 
-	p := models.NewPuzzle(cmd.Sides, cmd.MaxWords)
-	outpath := fmt.Sprintf("%s/solutions.txt", cmd.Outdir)
+	return reportSolutions(p, cmd.SolveCmd, solutions, complete, "")
+}
+
+func solveFile(cmd *SolveFileCmd) error {
+	if err := cmd.check(); err != nil {
+		return err
+	}
 
 	maxTime, err := time.ParseDuration(cmd.MaxTime)
 	if err != nil {
 		return fmt.Errorf("error: failed to parse max time: %w", err)
 	}
 
-	solutions, err := solve(p, maxTime, cmd.MaxBranch)
+	if cmd.Path == stdinName {
+		puzzle, err := loadPuzzle(os.Stdin)
+		if err != nil {
+			return fmt.Errorf("failed to load puzzle from stdin: %w", err)
+		}
+
+		log.Info().Msg("loaded puzzle from stdin")
+
+		return solvePuzzleFile(puzzle, maxTime, cmd, "")
+	}
+
+	info, err := os.Stat(cmd.Path)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to stat puzzle path: %w", err)
+	}
+
+	fpaths := []string{cmd.Path}
+
+	if info.IsDir() {
+		if cmd.Outdir == stdoutName {
+			return errDirToStdout
+		}
+
+		if fpaths, err = filepath.Glob(filepath.Join(cmd.Path, "*.json")); err != nil {
+			return fmt.Errorf("failed to find puzzle files: %w", err)
+		}
+
+		log.Info().
+			Str("dir", cmd.Path).
+			Int("count", len(fpaths)).
+			Msg("found puzzle files")
+	}
+
+	errs := []error{}
+
+	for _, fpath := range fpaths {
+		if err := solvePuzzlePath(fpath, maxTime, cmd); err != nil {
+			log.Error().Err(err).Str("path", fpath).Msg("failed to solve puzzle file")
+
+			errs = append(errs, fmt.Errorf("%s: %w", fpath, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func solvePuzzlePath(fpath string, maxTime time.Duration, cmd *SolveFileCmd) error {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return fmt.Errorf("failed to open puzzle file: %w", err)
+	}
+
+	defer f.Close()
+
+	puzzle, err := loadPuzzle(f)
+	if err != nil {
+		return fmt.Errorf("failed to load puzzle: %w", err)
+	}
+
+	log.Info().Str("path", fpath).Msg("loaded puzzle file")
+
+	base := filepath.Base(fpath)
+	name := strings.TrimSuffix(base, filepath.Ext(base))
+
+	return solvePuzzleFile(puzzle, maxTime, cmd, name)
+}
+
+func solvePuzzleFile(
+	puzzle *models.Puzzle,
+	maxTime time.Duration,
+	cmd *SolveFileCmd,
+	name string,
+) error {
+	if cmd.MaxWords > 0 {
+		puzzle.SetMaxWords(cmd.MaxWords)
+
+		log.Info().Int("maxWords", cmd.MaxWords).Msg("overriding puzzle max words")
 	}
 
-	if err = reportSolutions(solutions, outpath); err != nil {
+	solutions, complete, err := solve(puzzle, maxTime, cmd.SolveCmd)
+	if err != nil {
 		return err
 	}
 
-	return nil
+	return reportSolutions(puzzle, cmd.SolveCmd, solutions, complete, name)
+}
+
+// errCutShort is returned after the solutions are written, so scripts can
+// tell that they are not proven to be the shortest.
+var errCutShort = errors.New("search was cut short by the time limit, solutions may not be optimal")
+
+// errDirToStdout is returned for a puzzle dir with -o -, since the reports
+// for each puzzle would run together with nothing to tell them apart.
+var errDirToStdout = errors.New("solutions for a puzzle dir cannot be written to stdout, give an output dir with -o")
+
+var (
+	errNoPuzzle       = errors.New("no puzzle given (use --builtin, --file, or --sides)")
+	errSeveralPuzzles = errors.New("more than one puzzle given")
+)
+
+// loadPuzzleCmd loads the puzzle from a built-in file, a puzzle file, or
+// the given sides.
+func loadPuzzleCmd(cmd PuzzleCmd) (*models.Puzzle, error) {
+	given := 0
+	for _, isGiven := range []bool{cmd.Builtin != "", cmd.File != "", len(cmd.Sides) > 0} {
+		if isGiven {
+			given++
+		}
+	}
+
+	switch given {
+	case 0:
+		return nil, errNoPuzzle
+	case 1:
+	default:
+		return nil, errSeveralPuzzles
+	}
+
+	if len(cmd.Sides) > 0 {
+		return models.NewPuzzle(cmd.Sides, cmd.MaxWords)
+	}
+
+	var r io.Reader
+
+	switch {
+	case cmd.Builtin != "":
+		f, err := puzzles.Open(fmt.Sprintf("puzzles/%s", cmd.Builtin))
+		if err != nil {
+			return nil, fmt.Errorf("failed to open built-in puzzle file: %w", err)
+		}
+
+		defer f.Close()
+
+		r = f
+	case cmd.File == stdinName:
+		r = os.Stdin
+	default:
+		f, err := os.Open(cmd.File)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open puzzle file: %w", err)
+		}
+
+		defer f.Close()
+
+		r = f
+	}
+
+	puzzle, err := loadPuzzle(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load puzzle: %w", err)
+	}
+
+	if cmd.MaxWords > 0 {
+		puzzle.SetMaxWords(cmd.MaxWords)
+	}
+
+	return puzzle, nil
 }
 
-func loadPuzzle(puzzleFile fs.File) (*models.Puzzle, error) {
+func loadPuzzle(r io.Reader) (*models.Puzzle, error) {
 	var p models.Puzzle
 
-	if err := json.NewDecoder(puzzleFile).Decode(&p); err != nil {
+	if err := json.NewDecoder(r).Decode(&p); err != nil {
 		return nil, fmt.Errorf("failed to load puzzle JSON file: %w", err)
 	}
 
@@ -212,94 +558,258 @@ func loadPuzzle(puzzleFile fs.File) (*models.Puzzle, error) {
 func solve(
 	puzzle *models.Puzzle,
 	maxTime time.Duration,
-	maxBranch int,
-) (solving.SolutionsByWordCount, error) {
-	log.Info().
+	cmd SolveCmd,
+) (solving.SolutionsByWordCount, bool, error) {
+	progressInterval, err := time.ParseDuration(cmd.Progress)
+	if err != nil {
+		return solving.SolutionsByWordCount{}, false, fmt.Errorf("failed to parse progress interval: %w", err)
+	}
+
+	e := log.Info().
 		Strs("sides", puzzle.GetSides()).
+		Stringer("shape", puzzle.Shape()).
 		Stringer("letters", puzzle.GetLetterSet()).
 		Int("maxWords", puzzle.GetMaxWords()).
 		Float64("maxTimeSec", maxTime.Seconds()).
-		Int("maxBranch", maxBranch).
-		Msg("solving puzzle")
+		Int("maxBranch", cmd.MaxBranch).
+		Str("strategy", cmd.Strategy).
+		Int("workers", cmd.Workers).
+		Str("scoring", cmd.Scoring)
 
-	wordsFile, err := words.Open("words/scrabble-words.txt")
-	if err != nil {
-		return solving.SolutionsByWordCount{}, fmt.Errorf("failed to open words file: %w", err)
+	if c := cmd.Constraints(); !c.IsEmpty() {
+		e = e.Interface("constraints", c)
 	}
 
-	wordSource := solving.NewFileWordSource(wordsFile)
+	e.Msg("solving puzzle")
 
-	start := time.Now()
+	wordSource, closeWords, err := openWordSource(cmd.WordsCmd)
+	if err != nil {
+		return solving.SolutionsByWordCount{}, false, err
+	}
+
+	defer closeWords()
 
 	log.Info().Msg("starting solver")
 
-	solver := solving.NewSolver(puzzle, wordSource, maxBranch)
+	solver, err := solving.NewStepper(puzzle, wordSource, solving.StrategyOptions{
+		Strategy:    cmd.Strategy,
+		MaxBranch:   cmd.MaxBranch,
+		Workers:     cmd.Workers,
+		Scoring:     cmd.Scoring,
+		Constraints: cmd.Constraints(),
+	})
+	if err != nil {
+		return solving.SolutionsByWordCount{}, false, err
+	}
+
+	start := time.Now()
 	solutions := solving.SolutionsByWordCount{}
-	step := 0
 
-	for !solver.IsFinished() && (time.Since(start) <= maxTime) {
-		solver.Step()
+	ctx, cancel := context.WithTimeout(context.Background(), maxTime)
+	defer cancel()
 
-		step++
+	var best solving.Solution
+
+	opts := solving.StreamOptions{
+		ProgressInterval: progressInterval,
+		Progress: func(elapsed time.Duration) {
+			reportProgress(solver, elapsed)
+		},
+	}
+
+	for found := range solving.Stream(ctx, solver, opts) {
+		if best != nil && !found.Solution.IsBetter(best) {
+			continue
+		}
+
+		best = found.Solution
+
+		log.Info().
+			Strs("solution", best).
+			Str("startWord", found.StartWord).
+			Float64("elapsedSec", found.Elapsed.Seconds()).
+			Msg("found new best solution")
 	}
 
 	log.Info().
 		Float64("durSec", time.Since(start).Seconds()).
+		Bool("complete", solver.IsFinished()).
 		Msg("done solving")
 
 	for _, sln := range solver.GetSolutions() {
 		solutions.Add(sln)
 	}
 
-	return solutions, nil
+	return solutions, solver.IsFinished(), nil
+}
+
+const builtinWordsPath = "words/scrabble-words.txt"
+
+// openWordSource opens the word lists and blocklists given on the command
+// line. The returned func closes the opened files.
+func openWordSource(cmd WordsCmd) (solving.WordSource, func(), error) {
+	files := []fs.File{}
+	closeAll := func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}
+
+	openAll := func(fpaths []string) ([]solving.WordSource, error) {
+		sources := []solving.WordSource{}
+
+		for _, fpath := range fpaths {
+			f, err := os.Open(fpath)
+			if err != nil {
+				return nil, fmt.Errorf("failed to open words file: %w", err)
+			}
+
+			files = append(files, f)
+			sources = append(sources, solving.NewFileWordSource(f))
+		}
+
+		return sources, nil
+	}
+
+	sources, err := openAll(cmd.Words)
+	if err != nil {
+		closeAll()
+
+		return nil, nil, err
+	}
+
+	if len(sources) == 0 {
+		f, err := words.Open(builtinWordsPath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to open built-in words file: %w", err)
+		}
+
+		files = append(files, f)
+		sources = append(sources, solving.NewFileWordSource(f))
+	}
+
+	blocklists, err := openAll(cmd.Blocklist)
+	if err != nil {
+		closeAll()
+
+		return nil, nil, err
+	}
+
+	wordSource := solving.NewMultiWordSource(sources...)
+
+	if len(blocklists) > 0 {
+		wordSource = solving.NewExcludeWordSource(wordSource, solving.NewMultiWordSource(blocklists...))
+	}
+
+	return wordSource, closeAll, nil
+}
+
+// showSolution draws the solution on the puzzle box, to stdout unless the
+// solutions are being written there.
+func showSolution(puzzle *models.Puzzle, sln solving.Solution, outdir string) error {
+	out := os.Stdout
+	if outdir == stdoutName {
+		out = os.Stderr
+	}
+
+	if err := render.Text(out, puzzle, sln, render.TextOptions{Color: useColor(out)}); err != nil {
+		return fmt.Errorf("failed to draw solution: %w", err)
+	}
+
+	return nil
+}
+
+// useColor returns true if the file is a terminal that supports colors,
+// following the NO_COLOR convention (https://no-color.org).
+func useColor(f *os.File) bool {
+	if os.Getenv("NO_COLOR") != "" || os.Getenv("TERM") == "dumb" {
+		return false
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+type statsSolver interface {
+	Stats() solving.SolverStats
+}
+
+func reportProgress(solver solving.Stepper, elapsed time.Duration) {
+	e := log.Info().
+		Float64("elapsedSec", elapsed.Seconds()).
+		Int("solutions", len(solver.GetSolutions()))
+
+	if s, ok := solver.(statsSolver); ok {
+		stats := s.Stats()
+		byWordCount := zerolog.Dict()
+
+		wordCounts := maps.Keys(stats.SolutionsByWordCount)
+
+		slices.Sort(wordCounts)
+
+		for _, wc := range wordCounts {
+			byWordCount.Int(strconv.Itoa(wc), stats.SolutionsByWordCount[wc])
+		}
+
+		e = e.
+			Int("explorersRemaining", stats.ExplorersRemaining).
+			Int("explorersTotal", stats.ExplorersTotal).
+			Int("nodesExpanded", stats.NodesExpanded).
+			Dict("solutionsByWordCount", byWordCount).
+			Strs("best", stats.Best)
+	}
+
+	e.Msg("solving progress")
 }
 
 func reportSolutions(
+	puzzle *models.Puzzle,
+	cmd SolveCmd,
 	solutions solving.SolutionsByWordCount,
-	outpath string,
+	complete bool,
+	name string,
 ) error {
-	allSlns := solutions.All()
+	found := solutions.All()
+	allSlns := solving.SolutionFilter{
+		Top:           cmd.Top,
+		MaxWords:      cmd.MaxWordsOut,
+		MinTotalChars: cmd.MinTotalChars,
+		MaxTotalChars: cmd.MaxTotalChars,
+		MustInclude:   cmd.MustInclude,
+		Exclude:       cmd.Exclude,
+	}.Apply(found)
 
 	if len(allSlns) > 0 {
 		log.Info().
+			Int("found", len(found)).
 			Int("count", len(allSlns)).
 			Strs("best", allSlns[0]).
 			Msg("found solutions")
 	} else {
-		log.Info().Msg("no solutions found")
+		log.Info().Int("found", len(found)).Msg("no solutions to output")
 	}
 
-	outdir := path.Dir(outpath)
-
-	info, err := os.Stat(outdir)
-	if err != nil {
-		if !os.IsNotExist(err) {
-			return fmt.Errorf("failed to stat output dir: %w", err)
-		}
+	if err := writeReport(NewSolutionsReport(puzzle, cmd, allSlns, complete), cmd, name); err != nil {
+		return err
+	}
 
-		if err = os.Mkdir(outdir, 0750); err != nil {
-			return fmt.Errorf("failed to make output dir: %w", err)
+	if cmd.Show && len(allSlns) > 0 {
+		if err := showSolution(puzzle, allSlns[0], cmd.Outdir); err != nil {
+			return err
 		}
-	} else if !info.IsDir() {
-		return fmt.Errorf("'%s' is not a dir", outdir)
 	}
 
-	solutionsFile, err := os.Create(outpath)
-	if err != nil {
-		return fmt.Errorf("failed to create solutions file: %w", err)
+	if err := writeSVGs(puzzle, allSlns[:min(cmd.SVG, len(allSlns))], cmd.Outdir, name); err != nil {
+		return err
 	}
 
-	log.Info().Str("outpath", outpath).Msg("writing solutions to file")
-
-	defer solutionsFile.Close()
-
-	w := bufio.NewWriter(solutionsFile)
-
-	defer w.Flush()
-
-	for _, sln := range allSlns {
-		w.WriteString(sln.String())
-		w.WriteRune('\n')
+	// the shortest-solution strategies only prove optimality when they finish
+	if cmd.Strategy != solving.StrategyBestFirst && !complete {
+		return errCutShort
 	}
 
 	return nil
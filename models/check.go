@@ -0,0 +1,155 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Dictionary reports whether a word is known.
+type Dictionary interface {
+	Contains(word string) bool
+}
+
+type ProblemKind string
+
+// Problem is one way that a solution breaks the puzzle rules. Word is the
+// index of the word with the problem, and Position is the index of the
+// letter within the word. Either is -1 when it does not apply.
+type Problem struct {
+	Kind     ProblemKind `json:"kind"`
+	Word     int         `json:"word"`
+	Position int         `json:"position"`
+	Message  string      `json:"message"`
+}
+
+type CheckResult struct {
+	Words     []string  `json:"words"`
+	Valid     bool      `json:"valid"`
+	Problems  []Problem `json:"problems"`
+	Uncovered string    `json:"uncovered"`
+}
+
+const (
+	ProblemNoWords             ProblemKind = "noWords"
+	ProblemTooManyWords        ProblemKind = "tooManyWords"
+	ProblemWordTooShort        ProblemKind = "wordTooShort"
+	ProblemWordTooLong         ProblemKind = "wordTooLong"
+	ProblemWordNotInDictionary ProblemKind = "wordNotInDictionary"
+	ProblemLetterNotInPuzzle   ProblemKind = "letterNotInPuzzle"
+	ProblemSameSideAdjacent    ProblemKind = "sameSideAdjacent"
+	ProblemBrokenChain         ProblemKind = "brokenChain"
+	ProblemWordReused          ProblemKind = "wordReused"
+	ProblemUncoveredLetters    ProblemKind = "uncoveredLetters"
+)
+
+// CheckSolution checks the words against the puzzle rules and reports every
+// problem found. Words are uppercased before checking. If the dictionary is
+// nil, words are not checked against a dictionary.
+func (p *Puzzle) CheckSolution(words []string, dict Dictionary) *CheckResult {
+	words = slices.Clone(words)
+	for i, word := range words {
+		words[i] = strings.ToUpper(word)
+	}
+
+	problems := []Problem{}
+	addProblem := func(kind ProblemKind, word, pos int, format string, a ...any) {
+		problems = append(problems, Problem{
+			Kind:     kind,
+			Word:     word,
+			Position: pos,
+			Message:  fmt.Sprintf(format, a...),
+		})
+	}
+
+	if len(words) == 0 {
+		addProblem(ProblemNoWords, -1, -1, "no words given")
+	}
+
+	if len(words) > p.maxWords {
+		addProblem(ProblemTooManyWords, -1, -1,
+			"%d words is more than the max of %d", len(words), p.maxWords)
+	}
+
+	for i, word := range words {
+		for _, prob := range p.checkWord(word, dict) {
+			prob.Word = i
+			prob.Message = fmt.Sprintf("word %d (%s): %s", i+1, word, prob.Message)
+
+			problems = append(problems, prob)
+		}
+
+		if i == 0 {
+			continue
+		}
+
+		prev := []rune(words[i-1])
+		runes := []rune(word)
+
+		if p.rules.IsChainingRequired() && len(prev) > 0 && len(runes) > 0 && prev[len(prev)-1] != runes[0] {
+			addProblem(ProblemBrokenChain, i, 0,
+				"word %d (%s) does not start with '%c', the last letter of word %d (%s)",
+				i+1, word, prev[len(prev)-1], i, words[i-1])
+		}
+
+		if !p.rules.AllowWordReuse {
+			if j := slices.Index(words[:i], word); j >= 0 {
+				addProblem(ProblemWordReused, i, -1,
+					"word %d (%s) was already used as word %d", i+1, word, j+1)
+			}
+		}
+	}
+
+	uncovered := LetterSet{set: p.letterSet}.AndNot(NewLetterSet(words...))
+	if uncovered.Size() > 0 {
+		addProblem(ProblemUncoveredLetters, -1, -1, "letters not used: %s", uncovered)
+	}
+
+	return &CheckResult{
+		Words:     words,
+		Valid:     len(problems) == 0,
+		Problems:  problems,
+		Uncovered: uncovered.String(),
+	}
+}
+
+func (p *Puzzle) checkWord(word string, dict Dictionary) []Problem {
+	problems := []Problem{}
+	addProblem := func(kind ProblemKind, pos int, format string, a ...any) {
+		problems = append(problems, Problem{
+			Kind:     kind,
+			Position: pos,
+			Message:  fmt.Sprintf(format, a...),
+		})
+	}
+
+	runes := []rune(word)
+
+	if n, minLen := len(runes), p.rules.GetMinWordLength(); n < minLen {
+		addProblem(ProblemWordTooShort, -1, "has %d letters, fewer than the min of %d", n, minLen)
+	}
+
+	if n, maxLen := len(runes), p.rules.MaxWordLength; maxLen > 0 && n > maxLen {
+		addProblem(ProblemWordTooLong, -1, "has %d letters, more than the max of %d", n, maxLen)
+	}
+
+	if dict != nil && !dict.Contains(word) {
+		addProblem(ProblemWordNotInDictionary, -1, "not in the dictionary")
+	}
+
+	for i, ch := range runes {
+		side, found := p.antiConnections[ch]
+		if !found {
+			addProblem(ProblemLetterNotInPuzzle, i, "letter %d '%c' is not in the puzzle", i+1, ch)
+
+			continue
+		}
+
+		if i > 0 && !p.rules.AllowSameSideAdjacent && slices.Contains(side, runes[i-1]) {
+			addProblem(ProblemSameSideAdjacent, i,
+				"letters %d and %d ('%c', '%c') are on the same side", i, i+1, runes[i-1], ch)
+		}
+	}
+
+	return problems
+}
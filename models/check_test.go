@@ -0,0 +1,86 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+)
+
+type testDictionary map[string]bool
+
+func (d testDictionary) Contains(word string) bool {
+	return d[word]
+}
+
+func problemKinds(result *models.CheckResult) []models.ProblemKind {
+	kinds := []models.ProblemKind{}
+	for _, prob := range result.Problems {
+		kinds = append(kinds, prob.Kind)
+	}
+
+	return kinds
+}
+
+func TestCheckSolutionValid(t *testing.T) {
+	p, err := models.NewPuzzle([]string{"apl", "gnm", "tih", "ord"}, 4)
+
+	require.NoError(t, err)
+
+	result := p.CheckSolution([]string{"phantom", "marigold"}, testDictionary{"PHANTOM": true, "MARIGOLD": true})
+
+	assert.True(t, result.Valid)
+	assert.Empty(t, result.Problems)
+	assert.Empty(t, result.Uncovered)
+	assert.Equal(t, []string{"PHANTOM", "MARIGOLD"}, result.Words)
+}
+
+func TestCheckSolutionProblems(t *testing.T) {
+	p, err := models.NewPuzzle([]string{"apl", "gnm", "tih", "ord"}, 2)
+
+	require.NoError(t, err)
+
+	result := p.CheckSolution([]string{"PHANTOM", "MOAT", "HOP", "HOP"}, testDictionary{"PHANTOM": true})
+
+	require.False(t, result.Valid)
+
+	assert.ElementsMatch(t, []models.ProblemKind{
+		models.ProblemTooManyWords,
+		models.ProblemWordNotInDictionary,
+		models.ProblemWordNotInDictionary,
+		models.ProblemWordNotInDictionary,
+		models.ProblemBrokenChain,
+		models.ProblemBrokenChain,
+		models.ProblemWordReused,
+		models.ProblemUncoveredLetters,
+	}, problemKinds(result))
+	assert.Equal(t, "DGILR", result.Uncovered)
+}
+
+func TestCheckSolutionWordProblems(t *testing.T) {
+	p, err := models.NewPuzzle([]string{"apl", "gnm", "tih", "ord"}, 3)
+
+	require.NoError(t, err)
+
+	result := p.CheckSolution([]string{"TO", "OXAL"}, nil)
+
+	assert.ElementsMatch(t, []models.ProblemKind{
+		models.ProblemWordTooShort,
+		models.ProblemLetterNotInPuzzle,
+		models.ProblemSameSideAdjacent,
+		models.ProblemUncoveredLetters,
+	}, problemKinds(result))
+
+	for _, prob := range result.Problems {
+		switch prob.Kind {
+		case models.ProblemLetterNotInPuzzle:
+			assert.Equal(t, 1, prob.Word)
+			assert.Equal(t, 1, prob.Position)
+		case models.ProblemSameSideAdjacent:
+			assert.Equal(t, 1, prob.Word)
+			assert.Equal(t, 3, prob.Position)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"unicode"
 
 	"github.com/yourbasic/bit"
 )
@@ -15,23 +16,48 @@ type Puzzle struct {
 	maxWords        int
 	letterSet       *bit.Set
 	antiConnections map[rune][]rune
+	rules           Rules
 }
 
 type PuzzleData struct {
 	MaxWords int      `json:"maxWords"`
 	Sides    []string `json:"sides"`
+
+	// LettersPerSide optionally declares the expected shape. When given, it
+	// must match the number of letters on each side.
+	LettersPerSide []int `json:"lettersPerSide,omitempty"`
+
+	// Rules optionally changes the standard rules.
+	Rules Rules `json:"rules,omitzero"`
 }
 
 type Side []rune
 
-var errLetterRepeated = errors.New("letter is repeated")
+var (
+	ErrNoSides             = errors.New("puzzle has no sides")
+	ErrEmptySide           = errors.New("side is empty")
+	ErrNotLetter           = errors.New("not a letter")
+	ErrLetterRepeated      = errors.New("letter is repeated")
+	ErrMaxWordsNotPositive = errors.New("max words is not > 0")
+	ErrTooFewSides         = errors.New("puzzle has fewer than 3 sides")
+	ErrTooManyLetters      = errors.New("puzzle has too many letters")
+	ErrShapeMismatch       = errors.New("sides do not match declared letters per side")
+)
+
+const (
+	MinSides   = 3
+	MaxLetters = 64
+)
 
-func NewPuzzle(sides []string, maxWords int) *Puzzle {
+// NewPuzzle makes a valid puzzle. Side letters are uppercased.
+func NewPuzzle(sides []string, maxWords int) (*Puzzle, error) {
 	p := &Puzzle{}
 
-	p.Init(sides, maxWords)
+	if err := p.Init(sides, maxWords); err != nil {
+		return nil, err
+	}
 
-	return p
+	return p, nil
 }
 
 func (p *Puzzle) UnmarshalJSON(d []byte) error {
@@ -41,27 +67,39 @@ func (p *Puzzle) UnmarshalJSON(d []byte) error {
 		return err
 	}
 
-	letters := map[rune]struct{}{}
-	for _, side := range pd.Sides {
-		for _, letter := range side {
-			if _, found := letters[letter]; found {
-				return fmt.Errorf("invalid puzzle: %w", errLetterRepeated)
-			}
+	if err := p.Init(pd.Sides, pd.MaxWords); err != nil {
+		return err
+	}
 
-			letters[letter] = struct{}{}
-		}
+	if err := p.SetRules(pd.Rules); err != nil {
+		return fmt.Errorf("invalid puzzle: %w", err)
 	}
 
-	p.Init(pd.Sides, pd.MaxWords)
+	if len(pd.LettersPerSide) > 0 && !slices.Equal(pd.LettersPerSide, p.Shape().LettersPerSide) {
+		return fmt.Errorf(
+			"invalid puzzle: %w: declared %v, actual %v",
+			ErrShapeMismatch, pd.LettersPerSide, p.Shape().LettersPerSide)
+	}
 
 	return nil
 }
 
-func (p *Puzzle) Init(sides []string, maxWords int) {
+func (p *Puzzle) MarshalJSON() ([]byte, error) {
+	return json.Marshal(PuzzleData{
+		MaxWords:       p.maxWords,
+		Sides:          p.sides,
+		LettersPerSide: p.Shape().LettersPerSide,
+		Rules:          p.rules,
+	})
+}
+
+// Init sets up the puzzle with uppercased sides, then validates it.
+func (p *Puzzle) Init(sides []string, maxWords int) error {
 	antiConnections := map[rune][]rune{}
 	letterSet := bit.New()
+	upperSides := make([]string, len(sides))
 
-	for _, side := range sides {
+	for i, side := range sides {
 		side = strings.ToUpper(side)
 
 		for _, ch := range side {
@@ -69,12 +107,67 @@ func (p *Puzzle) Init(sides []string, maxWords int) {
 
 			antiConnections[ch] = []rune(side)
 		}
+
+		upperSides[i] = side
 	}
 
-	p.sides = sides
+	p.sides = upperSides
 	p.antiConnections = antiConnections
 	p.letterSet = letterSet
 	p.maxWords = maxWords
+
+	return p.Validate()
+}
+
+// Validate checks that the puzzle has at least three sides, that every side
+// has letters, that no letter is repeated, and that max words is positive.
+// Sides can have any number of letters. All of the violations found are
+// returned, joined.
+func (p *Puzzle) Validate() error {
+	errs := []error{}
+
+	switch n := len(p.sides); {
+	case n == 0:
+		errs = append(errs, ErrNoSides)
+	case n < MinSides:
+		errs = append(errs, fmt.Errorf("%w: %d", ErrTooFewSides, n))
+	}
+
+	letters := map[rune]struct{}{}
+
+	for i, side := range p.sides {
+		if side == "" {
+			errs = append(errs, fmt.Errorf("side %d: %w", i+1, ErrEmptySide))
+		}
+
+		for _, letter := range side {
+			if !unicode.IsLetter(letter) {
+				errs = append(errs, fmt.Errorf("side %d: %w: '%c'", i+1, ErrNotLetter, letter))
+
+				continue
+			}
+
+			if _, found := letters[letter]; found {
+				errs = append(errs, fmt.Errorf("side %d: %w: '%c'", i+1, ErrLetterRepeated, letter))
+			}
+
+			letters[letter] = struct{}{}
+		}
+	}
+
+	if len(letters) > MaxLetters {
+		errs = append(errs, fmt.Errorf("%w: %d > %d", ErrTooManyLetters, len(letters), MaxLetters))
+	}
+
+	if p.maxWords < 1 {
+		errs = append(errs, fmt.Errorf("%w: %d", ErrMaxWordsNotPositive, p.maxWords))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid puzzle: %w", errors.Join(errs...))
+	}
+
+	return nil
 }
 
 func (p *Puzzle) GetMaxWords() int {
@@ -85,10 +178,28 @@ func (p *Puzzle) SetMaxWords(maxWords int) {
 	p.maxWords = maxWords
 }
 
+func (p *Puzzle) GetRules() Rules {
+	return p.rules
+}
+
+func (p *Puzzle) SetRules(rules Rules) error {
+	if err := rules.Validate(); err != nil {
+		return err
+	}
+
+	p.rules = rules
+
+	return nil
+}
+
 func (p *Puzzle) IsWordAllowed(word string) bool {
-	const minWordLen = 3
+	n := len([]rune(word))
 
-	if len(word) < minWordLen {
+	if n < p.rules.GetMinWordLength() {
+		return false
+	}
+
+	if p.rules.MaxWordLength > 0 && n > p.rules.MaxWordLength {
 		return false
 	}
 
@@ -99,11 +210,14 @@ func (p *Puzzle) IsWordAllowed(word string) bool {
 			return false
 		}
 
-		var found bool
-
-		if disallowed, found = p.antiConnections[ch]; !found {
+		side, found := p.antiConnections[ch]
+		if !found {
 			return false
 		}
+
+		if !p.rules.AllowSameSideAdjacent {
+			disallowed = side
+		}
 	}
 
 	return true
@@ -113,6 +227,10 @@ func (p *Puzzle) GetSides() []string {
 	return p.sides
 }
 
+func (p *Puzzle) Shape() Shape {
+	return NewShape(p.sides)
+}
+
 func (p *Puzzle) GetLetterSet() LetterSet {
 	return LetterSet{set: p.letterSet}
 }
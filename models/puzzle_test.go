@@ -0,0 +1,81 @@
+package models_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+)
+
+func TestNewPuzzleValid(t *testing.T) {
+	p, err := models.NewPuzzle([]string{"apl", "GNM", "tIh", "ord"}, 4)
+
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"APL", "GNM", "TIH", "ORD"}, p.GetSides())
+	assert.Equal(t, 4, p.GetMaxWords())
+	assert.True(t, p.IsWordAllowed("PHANTOM"))
+}
+
+func TestNewPuzzleInvalid(t *testing.T) {
+	testCases := map[string]struct {
+		sides    []string
+		maxWords int
+		errs     []error
+	}{
+		"no sides":         {sides: []string{}, maxWords: 4, errs: []error{models.ErrNoSides}},
+		"empty side":       {sides: []string{"abc", ""}, maxWords: 4, errs: []error{models.ErrEmptySide}},
+		"not a letter":     {sides: []string{"abc", "d1f"}, maxWords: 4, errs: []error{models.ErrNotLetter}},
+		"repeated letter":  {sides: []string{"abc", "Ade"}, maxWords: 4, errs: []error{models.ErrLetterRepeated}},
+		"max words zero":   {sides: []string{"abc", "def"}, maxWords: 0, errs: []error{models.ErrMaxWordsNotPositive}},
+		"several problems": {sides: []string{"aa", " "}, maxWords: -1, errs: []error{models.ErrLetterRepeated, models.ErrNotLetter, models.ErrMaxWordsNotPositive}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := models.NewPuzzle(tc.sides, tc.maxWords)
+
+			for _, expected := range tc.errs {
+				assert.ErrorIs(t, err, expected)
+			}
+		})
+	}
+}
+
+func TestPuzzleUnmarshalJSONInvalid(t *testing.T) {
+	var p models.Puzzle
+
+	err := json.Unmarshal([]byte(`{"sides":["abc","cde","fgh"],"maxWords":3}`), &p)
+
+	assert.ErrorIs(t, err, models.ErrLetterRepeated)
+
+	err = json.Unmarshal([]byte(`{"sides":["abc","de","fgh"],"maxWords":3,"lettersPerSide":[3,3,3]}`), &p)
+
+	assert.ErrorIs(t, err, models.ErrShapeMismatch)
+}
+
+func TestPuzzleShape(t *testing.T) {
+	testCases := map[string]struct {
+		sides    []string
+		expected string
+	}{
+		"triangle": {sides: []string{"abcd", "efgh", "ijkl"}, expected: "triangle 3x4"},
+		"square":   {sides: []string{"apl", "gnm", "tih", "ord"}, expected: "square 4x3"},
+		"pentagon": {sides: []string{"abc", "de", "fgh", "ijk", "lm"}, expected: "pentagon (3,2,3,3,2)"},
+		"hexagon":  {sides: []string{"ab", "cd", "ef", "gh", "ij", "kl"}, expected: "hexagon 6x2"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			p, err := models.NewPuzzle(tc.sides, 3)
+
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, p.Shape().String())
+			assert.Equal(t, len(tc.sides), p.Shape().NumSides())
+		})
+	}
+}
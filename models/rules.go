@@ -0,0 +1,58 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Rules are the word validity and chaining rules for a puzzle. The zero
+// value gives the standard rules.
+type Rules struct {
+	// MinWordLength is the fewest letters a word can have. Zero means the
+	// default of 3.
+	MinWordLength int `json:"minWordLength,omitempty"`
+	// MaxWordLength is the most letters a word can have. Zero means no max.
+	MaxWordLength int `json:"maxWordLength,omitempty"`
+	// AllowSameSideAdjacent allows consecutive letters in a word to come from
+	// the same side.
+	AllowSameSideAdjacent bool `json:"allowSameSideAdjacent,omitempty"`
+	// AllowUnchained removes the requirement that each word after the first
+	// starts with the last letter of the previous word.
+	AllowUnchained bool `json:"allowUnchained,omitempty"`
+	// AllowWordReuse allows a word to be used more than once in a solution.
+	AllowWordReuse bool `json:"allowWordReuse,omitempty"`
+}
+
+const DefaultMinWordLength = 3
+
+var ErrInvalidRules = errors.New("invalid rules")
+
+func (r Rules) Validate() error {
+	if r.MinWordLength < 0 {
+		return fmt.Errorf("%w: min word length %d is negative", ErrInvalidRules, r.MinWordLength)
+	}
+
+	if r.MaxWordLength < 0 {
+		return fmt.Errorf("%w: max word length %d is negative", ErrInvalidRules, r.MaxWordLength)
+	}
+
+	if r.MaxWordLength > 0 && r.MaxWordLength < r.GetMinWordLength() {
+		return fmt.Errorf(
+			"%w: max word length %d is less than min word length %d",
+			ErrInvalidRules, r.MaxWordLength, r.GetMinWordLength())
+	}
+
+	return nil
+}
+
+func (r Rules) GetMinWordLength() int {
+	if r.MinWordLength == 0 {
+		return DefaultMinWordLength
+	}
+
+	return r.MinWordLength
+}
+
+func (r Rules) IsChainingRequired() bool {
+	return !r.AllowUnchained
+}
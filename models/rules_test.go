@@ -0,0 +1,66 @@
+package models_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+)
+
+func TestRulesWordAllowed(t *testing.T) {
+	p, err := models.NewPuzzle([]string{"apl", "gnm", "tih", "ord"}, 4)
+
+	require.NoError(t, err)
+
+	assert.False(t, p.IsWordAllowed("PAL"))
+	assert.False(t, p.IsWordAllowed("TO"))
+	assert.True(t, p.IsWordAllowed("PHANTOM"))
+
+	require.NoError(t, p.SetRules(models.Rules{
+		MinWordLength:         2,
+		MaxWordLength:         5,
+		AllowSameSideAdjacent: true,
+	}))
+
+	assert.True(t, p.IsWordAllowed("PAL"))
+	assert.True(t, p.IsWordAllowed("TO"))
+	assert.False(t, p.IsWordAllowed("PHANTOM"))
+}
+
+func TestRulesInvalid(t *testing.T) {
+	p, err := models.NewPuzzle([]string{"apl", "gnm", "tih", "ord"}, 4)
+
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, p.SetRules(models.Rules{MinWordLength: -1}), models.ErrInvalidRules)
+	assert.ErrorIs(t, p.SetRules(models.Rules{MinWordLength: 5, MaxWordLength: 4}), models.ErrInvalidRules)
+	assert.ErrorIs(t, p.SetRules(models.Rules{MaxWordLength: 2}), models.ErrInvalidRules)
+}
+
+func TestRulesJSON(t *testing.T) {
+	var p models.Puzzle
+
+	d := `{"sides":["APL","GNM","TIH","ORD"],"maxWords":4,"rules":{"minWordLength":4,"allowUnchained":true}}`
+
+	require.NoError(t, json.Unmarshal([]byte(d), &p))
+
+	assert.Equal(t, models.Rules{MinWordLength: 4, AllowUnchained: true}, p.GetRules())
+	assert.False(t, p.GetRules().IsChainingRequired())
+
+	out, err := json.Marshal(&p)
+
+	require.NoError(t, err)
+
+	assert.Contains(t, string(out), `"rules":{"minWordLength":4,"allowUnchained":true}`)
+
+	require.NoError(t, p.SetRules(models.Rules{}))
+
+	out, err = json.Marshal(&p)
+
+	require.NoError(t, err)
+
+	assert.NotContains(t, string(out), `"rules"`)
+}
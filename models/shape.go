@@ -0,0 +1,82 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jamestunnell/letter-boxed-solver/util"
+)
+
+// Shape is the geometry of a puzzle box: how many sides it has and how many
+// letters are on each side.
+type Shape struct {
+	LettersPerSide []int
+}
+
+var polygonNames = map[int]string{
+	3: "triangle",
+	4: "square",
+	5: "pentagon",
+	6: "hexagon",
+	7: "heptagon",
+	8: "octagon",
+}
+
+func NewShape(sides []string) Shape {
+	return Shape{
+		LettersPerSide: util.Map(sides, func(side string) int {
+			return len([]rune(side))
+		}),
+	}
+}
+
+func (s Shape) NumSides() int {
+	return len(s.LettersPerSide)
+}
+
+func (s Shape) NumLetters() int {
+	total := 0
+
+	for _, n := range s.LettersPerSide {
+		total += n
+	}
+
+	return total
+}
+
+// IsUniform returns true if every side has the same number of letters.
+func (s Shape) IsUniform() bool {
+	for _, n := range s.LettersPerSide {
+		if n != s.LettersPerSide[0] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Name returns the polygon name for the number of sides (e.g. "square").
+func (s Shape) Name() string {
+	if name, found := polygonNames[s.NumSides()]; found {
+		return name
+	}
+
+	return fmt.Sprintf("%d-gon", s.NumSides())
+}
+
+// String returns the name along with the letters per side, e.g. "square 4x3"
+// or "pentagon (3,2,3,3,2)" for uneven sides.
+func (s Shape) String() string {
+	if s.NumSides() == 0 {
+		return "empty"
+	}
+
+	if s.IsUniform() {
+		return fmt.Sprintf("%s %dx%d", s.Name(), s.NumSides(), s.LettersPerSide[0])
+	}
+
+	counts := util.Map(s.LettersPerSide, strconv.Itoa)
+
+	return fmt.Sprintf("%s (%s)", s.Name(), strings.Join(counts, ","))
+}
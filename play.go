@@ -0,0 +1,206 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/jamestunnell/letter-boxed-solver/playing"
+	"github.com/jamestunnell/letter-boxed-solver/render"
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+const (
+	playCmdUndo   = "/undo"
+	playCmdHint   = "/hint"
+	playCmdGiveUp = "/giveup"
+	playCmdQuit   = "/quit"
+	playCmdHelp   = "/help"
+
+	playGiveUpSolutions = 5
+)
+
+func play(cmd *PlayCmd) error {
+	puzzle, err := loadPuzzleCmd(cmd.PuzzleCmd)
+	if err != nil {
+		return err
+	}
+
+	wordSource, closeWords, err := openWordSource(cmd.WordsCmd)
+	if err != nil {
+		return err
+	}
+
+	defer closeWords()
+
+	maxTime, err := time.ParseDuration(cmd.MaxTime)
+	if err != nil {
+		return fmt.Errorf("invalid max time '%s': %w", cmd.MaxTime, err)
+	}
+
+	// keep solver logging from cluttering the game
+	zerolog.SetGlobalLevel(zerolog.WarnLevel)
+
+	game := playing.NewGame(puzzle, solving.ReadAllWords(wordSource))
+
+	return playGame(game, os.Stdin, os.Stdout, maxTime, useColor(os.Stdout))
+}
+
+// playGame runs the game loop, reading words and commands from r until the
+// puzzle is solved, the player gives up or quits, or r runs out.
+func playGame(game *playing.Game, r io.Reader, w io.Writer, maxTime time.Duration, color bool) error {
+	scanner := bufio.NewScanner(r)
+	hintLevel := solving.HintWordCount
+
+	printPlayHelp(w)
+
+	if err := printGame(w, game, color); err != nil {
+		return err
+	}
+
+	for {
+		fmt.Fprint(w, "> ")
+
+		if !scanner.Scan() {
+			fmt.Fprintln(w)
+
+			return scanner.Err()
+		}
+
+		input := strings.TrimSpace(scanner.Text())
+
+		switch strings.ToLower(input) {
+		case "":
+			continue
+		case playCmdHelp:
+			printPlayHelp(w)
+		case playCmdQuit:
+			return nil
+		case playCmdUndo:
+			word, ok := game.Undo()
+			if !ok {
+				fmt.Fprintln(w, "nothing to undo")
+
+				continue
+			}
+
+			hintLevel = solving.HintWordCount
+
+			fmt.Fprintf(w, "removed %s\n", word)
+
+			if err := printGame(w, game, color); err != nil {
+				return err
+			}
+		case playCmdHint:
+			if err := printHints(w, game, hintLevel, maxTime); err != nil {
+				fmt.Fprintf(w, "no hint: %v\n", err)
+
+				continue
+			}
+
+			hintLevel = min(hintLevel+1, solving.MaxHintLevel)
+		case playCmdGiveUp:
+			return printGiveUp(w, game, maxTime)
+		default:
+			if err := game.Play(input); err != nil {
+				fmt.Fprintf(w, "can't play that: %v\n", err)
+
+				continue
+			}
+
+			hintLevel = solving.HintWordCount
+
+			if err := printGame(w, game, color); err != nil {
+				return err
+			}
+
+			if game.IsSolved() {
+				fmt.Fprintf(w, "solved in %d words: %s\n",
+					len(game.GetWords()), solving.Solution(game.GetWords()))
+
+				return nil
+			}
+		}
+	}
+}
+
+func printPlayHelp(w io.Writer) {
+	fmt.Fprintln(w, "Type a word to play it. Commands:")
+	fmt.Fprintf(w, "  %-8s remove the last word\n", playCmdUndo)
+	fmt.Fprintf(w, "  %-8s get a hint (repeat for more)\n", playCmdHint)
+	fmt.Fprintf(w, "  %-8s show the best solutions and stop\n", playCmdGiveUp)
+	fmt.Fprintf(w, "  %-8s stop playing\n", playCmdQuit)
+	fmt.Fprintf(w, "  %-8s show this help\n", playCmdHelp)
+}
+
+func printGame(w io.Writer, game *playing.Game, color bool) error {
+	p := game.GetPuzzle()
+	words := game.GetWords()
+
+	fmt.Fprintln(w)
+
+	if err := render.Text(w, p, words, render.TextOptions{Color: color}); err != nil {
+		return fmt.Errorf("failed to draw puzzle: %w", err)
+	}
+
+	fmt.Fprintln(w)
+
+	if len(words) > 0 {
+		fmt.Fprintf(w, "words (%d of %d): %s\n", len(words), p.GetMaxWords(), solving.Solution(words))
+	}
+
+	fmt.Fprintf(w, "letters left: %s\n", game.Remaining())
+
+	return nil
+}
+
+func printHints(w io.Writer, game *playing.Game, level solving.HintLevel, maxTime time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), maxTime)
+	defer cancel()
+
+	hints, err := game.Hints(ctx, level)
+	if err != nil {
+		return err
+	}
+
+	for _, h := range hints {
+		fmt.Fprintf(w, "hint %d: %s\n", h.Level, h.Message)
+	}
+
+	return nil
+}
+
+func printGiveUp(w io.Writer, game *playing.Game, maxTime time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), maxTime)
+	defer cancel()
+
+	slns, err := game.BestSolutions(ctx, playGiveUpSolutions)
+	if errors.Is(err, context.DeadlineExceeded) {
+		fmt.Fprintln(w, "ran out of time looking for solutions")
+
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if len(slns) == 0 {
+		fmt.Fprintln(w, "no solutions found")
+
+		return nil
+	}
+
+	fmt.Fprintln(w, "best solutions:")
+
+	for _, sln := range slns {
+		fmt.Fprintf(w, "  %s\n", sln)
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+	"github.com/jamestunnell/letter-boxed-solver/playing"
+)
+
+func TestPlayGame(t *testing.T) {
+	p, err := models.NewPuzzle([]string{"abc", "def", "ghi", "jkl"}, 3)
+	require.NoError(t, err)
+
+	game := playing.NewGame(p, []string{"ADGJBEHK", "KCFIL"})
+	input := strings.NewReader("adgjbehk\nkcfil\n")
+
+	var out bytes.Buffer
+
+	require.NoError(t, playGame(game, input, &out, time.Second, false))
+
+	assert.Contains(t, out.String(), "words (1 of 3): ADGJBEHK\nletters left: CFIL\n")
+	assert.Contains(t, out.String(), "1 ADGJBEHK  2 KCFIL\n")
+	assert.Contains(t, out.String(), "solved in 2 words: ADGJBEHK, KCFIL\n")
+}
@@ -0,0 +1,150 @@
+package playing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+// Game tracks a player's progress on a puzzle: the words played so far and
+// the puzzle letters they cover.
+type Game struct {
+	puzzle *models.Puzzle
+	words  []string
+	dict   solving.WordSet
+	hinter *solving.Hinter
+	played []string
+}
+
+var (
+	ErrNotInDictionary = errors.New("not in the dictionary")
+	ErrNotAllowed      = errors.New("not allowed by the puzzle")
+	ErrBrokenChain     = errors.New("does not continue the chain")
+	ErrWordReused      = errors.New("already played")
+	ErrTooManyWords    = errors.New("no more words can be played")
+	ErrSolved          = errors.New("puzzle is already solved")
+)
+
+// NewGame starts a game on the puzzle, where words is the dictionary of
+// playable words.
+func NewGame(p *models.Puzzle, words []string) *Game {
+	return &Game{
+		puzzle: p,
+		words:  words,
+		dict:   solving.NewWordSet(words),
+		hinter: solving.NewHinter(p, words),
+		played: []string{},
+	}
+}
+
+func (g *Game) GetPuzzle() *models.Puzzle {
+	return g.puzzle
+}
+
+// GetWords returns the words played so far, in order.
+func (g *Game) GetWords() []string {
+	return slices.Clone(g.played)
+}
+
+// Covered returns the puzzle letters used by the words played so far.
+func (g *Game) Covered() models.LetterSet {
+	return models.NewLetterSet(g.played...)
+}
+
+// Remaining returns the puzzle letters not yet used.
+func (g *Game) Remaining() models.LetterSet {
+	return g.puzzle.GetLetterSet().AndNot(g.Covered())
+}
+
+func (g *Game) IsSolved() bool {
+	return len(g.played) > 0 && g.puzzle.DoLettersSolve(g.Covered())
+}
+
+// Play adds the word to the end of the chain, or returns an error saying
+// why it cannot be played. The word is uppercased first.
+func (g *Game) Play(word string) error {
+	word = strings.ToUpper(strings.TrimSpace(word))
+
+	switch {
+	case g.IsSolved():
+		return ErrSolved
+	case len(g.played) >= g.puzzle.GetMaxWords():
+		return fmt.Errorf("%w, the max is %d", ErrTooManyWords, g.puzzle.GetMaxWords())
+	case !g.dict.Contains(word):
+		return fmt.Errorf("%s is %w", word, ErrNotInDictionary)
+	case !g.puzzle.IsWordAllowed(word):
+		return fmt.Errorf("%s is %w", word, ErrNotAllowed)
+	}
+
+	rules := g.puzzle.GetRules()
+
+	if n := len(g.played); n > 0 && rules.IsChainingRequired() {
+		prev := []rune(g.played[n-1])
+		last := prev[len(prev)-1]
+
+		if []rune(word)[0] != last {
+			return fmt.Errorf("%s %w, it must start with '%c'", word, ErrBrokenChain, last)
+		}
+	}
+
+	if !rules.AllowWordReuse && slices.Contains(g.played, word) {
+		return fmt.Errorf("%s was %w", word, ErrWordReused)
+	}
+
+	g.played = append(g.played, word)
+
+	return nil
+}
+
+// Undo removes the last word played. It returns false if there are no
+// words to remove.
+func (g *Game) Undo() (string, bool) {
+	n := len(g.played)
+	if n == 0 {
+		return "", false
+	}
+
+	word := g.played[n-1]
+	g.played = g.played[:n-1]
+
+	return word, true
+}
+
+// Hints returns hints up to the given level for the next word to play.
+func (g *Game) Hints(ctx context.Context, level solving.HintLevel) ([]solving.Hint, error) {
+	return g.hinter.Hints(ctx, g.played, level)
+}
+
+// BestSolutions returns up to n of the shortest solutions to the puzzle,
+// best first, regardless of the words played so far.
+func (g *Game) BestSolutions(ctx context.Context, n int) ([]solving.Solution, error) {
+	solver := solving.NewDPSolver(g.puzzle, solving.NewSliceWordSource(g.words))
+
+	for !solver.IsFinished() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		solver.Step(ctx)
+	}
+
+	solutions := slices.Clone(solver.GetSolutions())
+
+	slices.SortStableFunc(solutions, func(a, b solving.Solution) int {
+		switch {
+		case a.IsBetter(b):
+			return -1
+		case b.IsBetter(a):
+			return 1
+		}
+
+		return strings.Compare(a.String(), b.String())
+	})
+
+	return solutions[:min(n, len(solutions))], nil
+}
@@ -0,0 +1,69 @@
+package playing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+	"github.com/jamestunnell/letter-boxed-solver/playing"
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+func TestGamePlay(t *testing.T) {
+	g := newTestGame(t)
+
+	require.NoError(t, g.Play("kcf"))
+
+	assert.Equal(t, []string{"KCF"}, g.GetWords())
+	assert.Equal(t, "CFK", g.Covered().String())
+	assert.False(t, g.IsSolved())
+
+	assert.ErrorIs(t, g.Play("KCFIL"), playing.ErrBrokenChain)
+	assert.ErrorIs(t, g.Play("FOO"), playing.ErrNotInDictionary)
+	assert.ErrorIs(t, g.Play("AB"), playing.ErrNotAllowed)
+
+	require.NoError(t, g.Play("FIL"))
+
+	word, ok := g.Undo()
+
+	assert.True(t, ok)
+	assert.Equal(t, "FIL", word)
+	assert.Equal(t, []string{"KCF"}, g.GetWords())
+}
+
+func TestGameSolve(t *testing.T) {
+	g := newTestGame(t)
+
+	require.NoError(t, g.Play("ADGJBEHK"))
+	require.NoError(t, g.Play("KCFIL"))
+
+	assert.True(t, g.IsSolved())
+	assert.Equal(t, 0, g.Remaining().Size())
+	assert.ErrorIs(t, g.Play("LAD"), playing.ErrSolved)
+}
+
+func TestGameHintsAndBestSolutions(t *testing.T) {
+	g := newTestGame(t)
+	ctx := context.Background()
+
+	hints, err := g.Hints(ctx, solving.HintWord)
+
+	require.NoError(t, err)
+	assert.Equal(t, "the next word is ADGJBEHK", hints[len(hints)-1].Message)
+
+	slns, err := g.BestSolutions(ctx, 5)
+
+	require.NoError(t, err)
+	assert.Equal(t, []solving.Solution{{"ADGJBEHK", "KCFIL"}}, slns)
+}
+
+func newTestGame(t *testing.T) *playing.Game {
+	p, err := models.NewPuzzle([]string{"abc", "def", "ghi", "jkl"}, 3)
+
+	require.NoError(t, err)
+
+	return playing.NewGame(p, []string{"ADGJBEHK", "KCFIL", "KCF", "FIL", "AB", "LAD"})
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/jamestunnell/letter-boxed-solver/rating"
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+func rate(cmd *RateCmd) error {
+	puzzle, err := loadPuzzleCmd(cmd.PuzzleCmd)
+	if err != nil {
+		return err
+	}
+
+	wordSource, closeWords, err := openWordSource(cmd.WordsCmd)
+	if err != nil {
+		return err
+	}
+
+	defer closeWords()
+
+	commonWords := []string{}
+
+	if cmd.CommonWords != "" {
+		f, err := os.Open(cmd.CommonWords)
+		if err != nil {
+			return fmt.Errorf("failed to open common words file: %w", err)
+		}
+
+		defer f.Close()
+
+		commonWords = solving.ReadAllWords(solving.NewFileWordSource(f))
+	}
+
+	log.Info().
+		Strs("sides", puzzle.GetSides()).
+		Int("maxWords", puzzle.GetMaxWords()).
+		Int("commonWords", len(commonWords)).
+		Msg("rating puzzle")
+
+	r, err := rating.Rate(context.Background(), puzzle, solving.ReadAllWords(wordSource), commonWords)
+	if err != nil {
+		return fmt.Errorf("failed to rate puzzle: %w", err)
+	}
+
+	if cmd.JSON {
+		enc := json.NewEncoder(os.Stdout)
+
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(r)
+	}
+
+	fmt.Printf("score: %.1f (%s)\n", r.Score, r.Label)
+
+	for _, c := range r.Components {
+		fmt.Printf("  %-22s value=%-10.4g hardness=%.2f weight=%.2f\n", c.Name, c.Value, c.Hardness, c.Weight)
+	}
+
+	return nil
+}
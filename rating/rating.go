@@ -0,0 +1,210 @@
+package rating
+
+import (
+	"context"
+	"math"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+// Rating is a puzzle difficulty score from 0 (easiest) to 100 (hardest),
+// with the components it was computed from.
+type Rating struct {
+	Score      float64     `json:"score"`
+	Label      string      `json:"label"`
+	Components []Component `json:"components"`
+}
+
+// Component is one difficulty metric. Hardness is the metric scaled to
+// [0, 1], and the score is the weighted average of component hardness.
+type Component struct {
+	Name     string  `json:"name"`
+	Value    float64 `json:"value"`
+	Hardness float64 `json:"hardness"`
+	Weight   float64 `json:"weight"`
+}
+
+const (
+	LabelEasy   = "easy"
+	LabelMedium = "medium"
+	LabelHard   = "hard"
+
+	ComponentAllowedWords           = "allowedWords"
+	ComponentUpToTwoWordSolutions   = "upToTwoWordSolutions"
+	ComponentUpToThreeWordSolutions = "upToThreeWordSolutions"
+	ComponentLetterRarity           = "letterRarity"
+	ComponentCommonWordSolution     = "commonWordSolution"
+
+	mediumMinScore = 35.0
+	hardMinScore   = 65.0
+)
+
+// Rate scores the puzzle for difficulty using the allowed words from the
+// dictionary, the number of solutions with at most 2 and at most 3 words,
+// and the average rarity of the puzzle letters under weighted scoring. If
+// common words are given, it also checks whether the puzzle can be solved
+// using only common words. Otherwise that component is left out.
+func Rate(
+	ctx context.Context,
+	p *models.Puzzle,
+	words []string,
+	commonWords []string,
+) (*Rating, error) {
+	allowed := []string{}
+	for _, word := range words {
+		if p.IsWordAllowed(word) {
+			allowed = append(allowed, word)
+		}
+	}
+
+	counts := solving.CountSolutions(p, solving.NewSliceWordSource(allowed), 3)
+	upToTwo := counts[1] + counts[2]
+	upToThree := upToTwo + counts[3]
+	infos := make([]*solving.WordInfo, len(allowed))
+
+	for i, word := range allowed {
+		infos[i] = solving.NewWordInfo(word)
+	}
+
+	components := []Component{
+		{
+			Name:     ComponentAllowedWords,
+			Value:    float64(len(allowed)),
+			Hardness: logHardness(len(allowed), 200, 1500),
+			Weight:   0.25,
+		},
+		{
+			Name:     ComponentUpToTwoWordSolutions,
+			Value:    float64(upToTwo),
+			Hardness: logHardness(upToTwo, 0, 50),
+			Weight:   0.3,
+		},
+		{
+			Name:     ComponentUpToThreeWordSolutions,
+			Value:    float64(upToThree),
+			Hardness: logHardness(upToThree, 100, 50000),
+			Weight:   0.2,
+		},
+		letterRarity(p, infos),
+	}
+
+	if len(commonWords) > 0 {
+		c, err := commonWordSolution(ctx, p, allowed, commonWords)
+		if err != nil {
+			return nil, err
+		}
+
+		components = append(components, c)
+	}
+
+	return NewRating(components), nil
+}
+
+func NewRating(components []Component) *Rating {
+	total, totalWeight := 0.0, 0.0
+
+	for _, c := range components {
+		total += c.Hardness * c.Weight
+		totalWeight += c.Weight
+	}
+
+	score := 0.0
+	if totalWeight > 0 {
+		score = 100 * total / totalWeight
+	}
+
+	return &Rating{
+		Score:      score,
+		Label:      Label(score),
+		Components: components,
+	}
+}
+
+func Label(score float64) string {
+	switch {
+	case score >= hardMinScore:
+		return LabelHard
+	case score >= mediumMinScore:
+		return LabelMedium
+	}
+
+	return LabelEasy
+}
+
+// letterRarity averages the weights of the puzzle letters under weighted
+// scoring, where a letter's weight is the fraction of allowed words that do
+// not contain it.
+func letterRarity(p *models.Puzzle, infos []*solving.WordInfo) Component {
+	scoring := solving.NewWeightedScoring(infos)
+	letters := p.GetLetterSet()
+	rarity := 1.0
+
+	if len(infos) > 0 {
+		rarity = scoring.Score(letters) / float64(letters.Size())
+	}
+
+	return Component{
+		Name:     ComponentLetterRarity,
+		Value:    rarity,
+		Hardness: clamp((rarity - 0.55) / 0.2),
+		Weight:   0.1,
+	}
+}
+
+func commonWordSolution(
+	ctx context.Context,
+	p *models.Puzzle,
+	allowed []string,
+	commonWords []string,
+) (Component, error) {
+	common := map[string]struct{}{}
+	for _, word := range commonWords {
+		common[word] = struct{}{}
+	}
+
+	allowedCommon := []string{}
+	for _, word := range allowed {
+		if _, found := common[word]; found {
+			allowedCommon = append(allowedCommon, word)
+		}
+	}
+
+	solver := solving.NewDPSolver(p, solving.NewSliceWordSource(allowedCommon))
+
+	for !solver.IsFinished() {
+		if err := ctx.Err(); err != nil {
+			return Component{}, err
+		}
+
+		solver.Step(ctx)
+	}
+
+	c := Component{
+		Name:     ComponentCommonWordSolution,
+		Value:    0,
+		Hardness: 1,
+		Weight:   0.15,
+	}
+
+	if len(solver.GetSolutions()) > 0 {
+		c.Value = 1
+		c.Hardness = 0
+	}
+
+	return c, nil
+}
+
+// logHardness maps a count onto [0, 1] on a log scale, where counts at or
+// below the hard limit are 1 and counts at or above the easy limit are 0.
+func logHardness(count, hard, easy int) float64 {
+	x := math.Log1p(float64(count))
+	lo := math.Log1p(float64(hard))
+	hi := math.Log1p(float64(easy))
+
+	return clamp((hi - x) / (hi - lo))
+}
+
+func clamp(x float64) float64 {
+	return math.Max(0, math.Min(1, x))
+}
@@ -0,0 +1,53 @@
+package rating_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+	"github.com/jamestunnell/letter-boxed-solver/rating"
+)
+
+func TestLabel(t *testing.T) {
+	assert.Equal(t, rating.LabelEasy, rating.Label(10))
+	assert.Equal(t, rating.LabelMedium, rating.Label(50))
+	assert.Equal(t, rating.LabelHard, rating.Label(90))
+}
+
+func TestNewRating(t *testing.T) {
+	r := rating.NewRating([]rating.Component{
+		{Name: "a", Hardness: 1, Weight: 3},
+		{Name: "b", Hardness: 0, Weight: 1},
+	})
+
+	assert.InDelta(t, 75.0, r.Score, 1e-9)
+	assert.Equal(t, rating.LabelHard, r.Label)
+}
+
+func TestRate(t *testing.T) {
+	p, err := models.NewPuzzle([]string{"abc", "def", "ghi", "jkl"}, 3)
+
+	require.NoError(t, err)
+
+	words := []string{"ADGJBEHK", "KCFIL", "KCF", "FIL"}
+
+	r, err := rating.Rate(context.Background(), p, words, []string{"KCF", "FIL"})
+
+	require.NoError(t, err)
+
+	require.Len(t, r.Components, 5)
+
+	values := map[string]float64{}
+	for _, c := range r.Components {
+		values[c.Name] = c.Value
+	}
+
+	assert.Equal(t, 4.0, values[rating.ComponentAllowedWords])
+	assert.Equal(t, 1.0, values[rating.ComponentUpToTwoWordSolutions])
+	assert.Equal(t, 2.0, values[rating.ComponentUpToThreeWordSolutions])
+	assert.Equal(t, 0.0, values[rating.ComponentCommonWordSolution])
+	assert.Equal(t, rating.LabelHard, r.Label)
+}
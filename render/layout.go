@@ -0,0 +1,59 @@
+package render
+
+import (
+	"math"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+)
+
+type point struct {
+	X, Y float64
+}
+
+// layout places the puzzle sides on a regular polygon centered at the
+// origin, with a circumradius of 1. Sides run clockwise from the top, so a
+// square puzzle has its first side along the top edge.
+type layout struct {
+	vertices []point
+	// order has the letters side by side, for drawing in a stable order
+	order   []rune
+	letters map[rune]point
+	// normals are the outward unit normals of the letters' sides
+	normals map[rune]point
+}
+
+func newLayout(p *models.Puzzle) *layout {
+	sides := p.GetSides()
+	n := len(sides)
+	l := &layout{
+		vertices: make([]point, n),
+		order:    []rune{},
+		letters:  map[rune]point{},
+		normals:  map[rune]point{},
+	}
+
+	// start half a side before straight up, so the first side is level
+	for i := range n {
+		angle := -math.Pi/2 - math.Pi/float64(n) + 2*math.Pi*float64(i)/float64(n)
+
+		l.vertices[i] = point{X: math.Cos(angle), Y: math.Sin(angle)}
+	}
+
+	for i, side := range sides {
+		from, to := l.vertices[i], l.vertices[(i+1)%n]
+		mid := point{X: (from.X + to.X) / 2, Y: (from.Y + to.Y) / 2}
+		midLen := math.Hypot(mid.X, mid.Y)
+		normal := point{X: mid.X / midLen, Y: mid.Y / midLen}
+		runes := []rune(side)
+
+		for j, r := range runes {
+			t := float64(j+1) / float64(len(runes)+1)
+
+			l.order = append(l.order, r)
+			l.letters[r] = point{X: from.X + t*(to.X-from.X), Y: from.Y + t*(to.Y-from.Y)}
+			l.normals[r] = normal
+		}
+	}
+
+	return l
+}
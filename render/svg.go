@@ -0,0 +1,111 @@
+package render
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+const (
+	svgSize      = 400.0
+	svgRadius    = 140.0
+	svgLabelDist = 24.0
+)
+
+// wordColors are used for the solution words in order, repeating if there
+// are more words than colors.
+var wordColors = []string{"#d62728", "#1f77b4", "#2ca02c", "#9467bd", "#ff7f0e", "#17becf"}
+
+// SVG draws the puzzle box with its letters, and each solution word as a
+// colored line through its letters, numbered in order. The solution may be
+// empty to draw just the box.
+func SVG(w io.Writer, p *models.Puzzle, sln solving.Solution) error {
+	l := newLayout(p)
+	bw := bufio.NewWriter(w)
+	center := svgSize / 2
+	toSVG := func(pt point) point {
+		return point{X: center + svgRadius*pt.X, Y: center + svgRadius*pt.Y}
+	}
+
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" width="%g" height="%g" viewBox="0 0 %g %g">`+"\n",
+		svgSize, svgSize, svgSize, svgSize)
+	fmt.Fprintf(bw, "<title>%s</title>\n", html.EscapeString(svgTitle(p, sln)))
+	fmt.Fprintf(bw, `<rect width="100%%" height="100%%" fill="white"/>`+"\n")
+
+	vertices := make([]string, len(l.vertices))
+	for i, v := range l.vertices {
+		pt := toSVG(v)
+
+		vertices[i] = fmt.Sprintf("%.1f,%.1f", pt.X, pt.Y)
+	}
+
+	fmt.Fprintf(bw, `<polygon points="%s" fill="none" stroke="black" stroke-width="3"/>`+"\n",
+		strings.Join(vertices, " "))
+
+	for i, word := range sln {
+		color := wordColors[i%len(wordColors)]
+		points := []string{}
+
+		for _, r := range word {
+			if letter, found := l.letters[r]; found {
+				pt := toSVG(letter)
+
+				points = append(points, fmt.Sprintf("%.1f,%.1f", pt.X, pt.Y))
+			}
+		}
+
+		fmt.Fprintf(bw,
+			`<polyline points="%s" fill="none" stroke="%s" stroke-width="4" stroke-linejoin="round" stroke-linecap="round" opacity="0.8"/>`+"\n",
+			strings.Join(points, " "), color)
+	}
+
+	for _, r := range l.order {
+		pt := toSVG(l.letters[r])
+		normal := l.normals[r]
+
+		fmt.Fprintf(bw, `<circle cx="%.1f" cy="%.1f" r="7" fill="white" stroke="black" stroke-width="2"/>`+"\n",
+			pt.X, pt.Y)
+		fmt.Fprintf(bw,
+			`<text x="%.1f" y="%.1f" font-family="monospace" font-size="22" font-weight="bold" text-anchor="middle" dominant-baseline="central">%s</text>`+"\n",
+			pt.X+svgLabelDist*normal.X, pt.Y+svgLabelDist*normal.Y, html.EscapeString(string(r)))
+	}
+
+	// number each word at its first letter, drawn last so it is on top
+	for i, word := range sln {
+		runes := []rune(word)
+		if len(runes) == 0 {
+			continue
+		}
+
+		letter, found := l.letters[runes[0]]
+		if !found {
+			continue
+		}
+
+		pt := toSVG(letter)
+
+		fmt.Fprintf(bw, `<circle cx="%.1f" cy="%.1f" r="9" fill="%s"/>`+"\n",
+			pt.X, pt.Y, wordColors[i%len(wordColors)])
+		fmt.Fprintf(bw,
+			`<text x="%.1f" y="%.1f" font-family="sans-serif" font-size="12" font-weight="bold" fill="white" text-anchor="middle" dominant-baseline="central">%d</text>`+"\n",
+			pt.X, pt.Y, i+1)
+	}
+
+	fmt.Fprintln(bw, "</svg>")
+
+	return bw.Flush()
+}
+
+func svgTitle(p *models.Puzzle, sln solving.Solution) string {
+	title := strings.Join(p.GetSides(), " ")
+	if len(sln) > 0 {
+		title += ": " + sln.String()
+	}
+
+	return title
+}
@@ -0,0 +1,67 @@
+package render_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+	"github.com/jamestunnell/letter-boxed-solver/render"
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+func TestSVG(t *testing.T) {
+	p, err := models.NewPuzzle([]string{"apl", "gnm", "tih", "ord"}, 4)
+
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+
+	require.NoError(t, render.SVG(&buf, p, solving.Solution{"PHANTOM", "MARIGOLD"}))
+
+	counts := countElements(t, buf.Bytes())
+
+	assert.Equal(t, 1, counts["polygon"])
+	assert.Equal(t, 2, counts["polyline"])
+	// 12 letters plus 2 word numbers
+	assert.Equal(t, 14, counts["text"])
+}
+
+func TestSVGNoSolution(t *testing.T) {
+	p, err := models.NewPuzzle([]string{"ab", "cd", "ef"}, 3)
+
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+
+	require.NoError(t, render.SVG(&buf, p, nil))
+
+	counts := countElements(t, buf.Bytes())
+
+	assert.Equal(t, 0, counts["polyline"])
+	assert.Equal(t, 6, counts["text"])
+}
+
+func countElements(t *testing.T, d []byte) map[string]int {
+	t.Helper()
+
+	counts := map[string]int{}
+	dec := xml.NewDecoder(bytes.NewReader(d))
+
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return counts
+		}
+
+		require.NoError(t, err)
+
+		if start, ok := tok.(xml.StartElement); ok {
+			counts[start.Name.Local]++
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package render
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+type TextOptions struct {
+	// Color highlights the solution words and the letters they cover with
+	// ANSI colors.
+	Color bool
+}
+
+const (
+	ansiReset = "\x1b[0m"
+	ansiDim   = "\x1b[2m"
+)
+
+// ansiWordColors are bold versions of the SVG word colors, as near as the
+// basic ANSI colors get.
+var ansiWordColors = []string{"\x1b[1;31m", "\x1b[1;34m", "\x1b[1;32m", "\x1b[1;35m", "\x1b[1;33m", "\x1b[1;36m"}
+
+// textBox draws the letters of a puzzle, each followed by the order it is
+// first visited in by the solution, if any.
+type textBox struct {
+	opts      TextOptions
+	sln       solving.Solution
+	order     map[rune]int
+	wordIndex map[rune]int
+	width     int
+}
+
+// Text draws the puzzle as a box in the classic layout, with one side along
+// each edge clockwise from the top. Shapes other than a square with the same
+// number of letters per side are drawn as a list of sides. If a solution is
+// given, each letter it covers is marked with the order it was reached in,
+// and the words are listed below the box.
+func Text(w io.Writer, p *models.Puzzle, sln solving.Solution, opts TextOptions) error {
+	tb := newTextBox(sln, opts)
+	bw := bufio.NewWriter(w)
+	shape := p.Shape()
+
+	if shape.NumSides() == 4 && shape.IsUniform() {
+		tb.writeSquare(bw, p.GetSides())
+	} else {
+		tb.writeSides(bw, p.GetSides())
+	}
+
+	if len(sln) > 0 {
+		tb.writeLegend(bw)
+	}
+
+	return bw.Flush()
+}
+
+func newTextBox(sln solving.Solution, opts TextOptions) *textBox {
+	tb := &textBox{
+		opts:      opts,
+		sln:       sln,
+		order:     map[rune]int{},
+		wordIndex: map[rune]int{},
+		width:     1,
+	}
+
+	for i, word := range sln {
+		for _, r := range word {
+			if _, found := tb.order[r]; !found {
+				tb.order[r] = len(tb.order) + 1
+				tb.wordIndex[r] = i
+			}
+		}
+	}
+
+	if len(tb.order) > 0 {
+		tb.width += len(strconv.Itoa(len(tb.order)))
+	}
+
+	return tb
+}
+
+// cell returns the letter and its order, padded to the cell width. Padding
+// goes on the left if alignRight is set.
+func (tb *textBox) cell(r rune, alignRight bool) string {
+	text := string(r)
+	if order, found := tb.order[r]; found {
+		text += strconv.Itoa(order)
+	}
+
+	padding := strings.Repeat(" ", tb.width-len([]rune(text)))
+
+	if tb.opts.Color {
+		if i, found := tb.wordIndex[r]; found {
+			text = ansiWordColors[i%len(ansiWordColors)] + text + ansiReset
+		} else if len(tb.sln) > 0 {
+			text = ansiDim + text + ansiReset
+		}
+	}
+
+	if alignRight {
+		return padding + text
+	}
+
+	return text + padding
+}
+
+func (tb *textBox) writeSquare(w io.Writer, sides []string) {
+	top, right := []rune(sides[0]), []rune(sides[1])
+	bottom, left := []rune(sides[2]), []rune(sides[3])
+
+	// bottom and left sides run clockwise, so they read in reverse
+	slices.Reverse(bottom)
+	slices.Reverse(left)
+
+	n := len(top)
+	margin := strings.Repeat(" ", tb.width+1)
+	inner := n*(tb.width+3) + 1
+	edge := margin + "+" + strings.Repeat("-", inner) + "+"
+
+	writeEdgeLetters := func(letters []rune) {
+		line := margin + " "
+		for _, r := range letters {
+			line += "  " + tb.cell(r, false) + " "
+		}
+
+		fmt.Fprintln(w, strings.TrimRight(line, " "))
+	}
+
+	writeEdgeLetters(top)
+	fmt.Fprintln(w, edge)
+
+	for i := range n {
+		fmt.Fprintf(w, "%s |%s| %s\n",
+			tb.cell(left[i], true), strings.Repeat(" ", inner), strings.TrimRight(tb.cell(right[i], false), " "))
+	}
+
+	fmt.Fprintln(w, edge)
+	writeEdgeLetters(bottom)
+}
+
+func (tb *textBox) writeSides(w io.Writer, sides []string) {
+	for i, side := range sides {
+		cells := []string{}
+		for _, r := range side {
+			cells = append(cells, tb.cell(r, false))
+		}
+
+		fmt.Fprintf(w, "side %d: %s\n", i+1, strings.TrimRight(strings.Join(cells, " "), " "))
+	}
+}
+
+func (tb *textBox) writeLegend(w io.Writer) {
+	words := make([]string, len(tb.sln))
+
+	for i, word := range tb.sln {
+		words[i] = fmt.Sprintf("%d %s", i+1, word)
+
+		if tb.opts.Color {
+			words[i] = ansiWordColors[i%len(ansiWordColors)] + words[i] + ansiReset
+		}
+	}
+
+	fmt.Fprintln(w, strings.Join(words, "  "))
+}
@@ -0,0 +1,71 @@
+package render_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+	"github.com/jamestunnell/letter-boxed-solver/render"
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+func TestTextSquare(t *testing.T) {
+	p, err := models.NewPuzzle([]string{"abc", "def", "ghi", "jkl"}, 3)
+
+	require.NoError(t, err)
+
+	var sb strings.Builder
+
+	require.NoError(t, render.Text(&sb, p, nil, render.TextOptions{}))
+
+	expected := `     A   B   C
+  +-------------+
+L |             | D
+K |             | E
+J |             | F
+  +-------------+
+     I   H   G
+`
+
+	assert.Equal(t, expected, sb.String())
+}
+
+func TestTextSolution(t *testing.T) {
+	p, err := models.NewPuzzle([]string{"abc", "def", "ghi", "jkl"}, 3)
+
+	require.NoError(t, err)
+
+	var sb strings.Builder
+
+	sln := solving.Solution{"ADGJBEHK", "KCFIL"}
+
+	require.NoError(t, render.Text(&sb, p, sln, render.TextOptions{}))
+
+	lines := strings.Split(sb.String(), "\n")
+
+	assert.Equal(t, "       A1    B5    C9", lines[0])
+	assert.Equal(t, "L12 |                   | D2", lines[2])
+	assert.Equal(t, "1 ADGJBEHK  2 KCFIL", lines[7])
+	assert.NotContains(t, sb.String(), "\x1b[")
+
+	sb.Reset()
+
+	require.NoError(t, render.Text(&sb, p, sln, render.TextOptions{Color: true}))
+
+	assert.Contains(t, sb.String(), "\x1b[1;34mC9\x1b[0m")
+}
+
+func TestTextOtherShape(t *testing.T) {
+	p, err := models.NewPuzzle([]string{"ab", "cd", "ef"}, 3)
+
+	require.NoError(t, err)
+
+	var sb strings.Builder
+
+	require.NoError(t, render.Text(&sb, p, nil, render.TextOptions{}))
+
+	assert.Equal(t, "side 1: A B\nside 2: C D\nside 3: E F\n", sb.String())
+}
@@ -0,0 +1,253 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+	"github.com/jamestunnell/letter-boxed-solver/render"
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+const (
+	formatText = "text"
+	formatJSON = "json"
+	formatCSV  = "csv"
+
+	stdoutName = "-"
+	stdinName  = "-"
+)
+
+type SolutionsReport struct {
+	Puzzle   *models.Puzzle `json:"puzzle"`
+	Settings ReportSettings `json:"settings"`
+	// Complete is false if the time limit cut the search short. For the
+	// exact and dp strategies, it means the solutions are the shortest.
+	Complete  bool             `json:"complete"`
+	Solutions []SolutionReport `json:"solutions"`
+}
+
+type ReportSettings struct {
+	MaxBranch   int                 `json:"maxBranch"`
+	MaxTime     string              `json:"maxTime"`
+	Strategy    string              `json:"strategy"`
+	Workers     int                 `json:"workers"`
+	Scoring     string              `json:"scoring"`
+	Constraints solving.Constraints `json:"constraints,omitzero"`
+}
+
+type SolutionReport struct {
+	Words      []string `json:"words"`
+	WordCount  int      `json:"wordCount"`
+	TotalChars int      `json:"totalChars"`
+	Letters    string   `json:"letters"`
+}
+
+var errUnknownFormat = errors.New("unknown output format")
+
+func formatExt(format string) (string, error) {
+	switch format {
+	case formatText:
+		return "txt", nil
+	case formatJSON:
+		return "json", nil
+	case formatCSV:
+		return "csv", nil
+	}
+
+	return "", fmt.Errorf("%w '%s'", errUnknownFormat, format)
+}
+
+func NewSolutionsReport(
+	puzzle *models.Puzzle,
+	cmd SolveCmd,
+	solutions []solving.Solution,
+	complete bool,
+) *SolutionsReport {
+	slnReports := make([]SolutionReport, len(solutions))
+
+	for i, sln := range solutions {
+		slnReports[i] = SolutionReport{
+			Words:      sln,
+			WordCount:  len(sln),
+			TotalChars: sln.TotalChars(),
+			Letters:    sln.Letters().String(),
+		}
+	}
+
+	return &SolutionsReport{
+		Puzzle: puzzle,
+		Settings: ReportSettings{
+			MaxBranch:   cmd.MaxBranch,
+			MaxTime:     cmd.MaxTime,
+			Strategy:    cmd.Strategy,
+			Workers:     cmd.Workers,
+			Scoring:     cmd.Scoring,
+			Constraints: cmd.Constraints(),
+		},
+		Complete:  complete,
+		Solutions: slnReports,
+	}
+}
+
+func (r *SolutionsReport) Write(w io.Writer, format string) error {
+	switch format {
+	case formatText:
+		return r.writeText(w)
+	case formatJSON:
+		return r.writeJSON(w)
+	case formatCSV:
+		return r.writeCSV(w)
+	}
+
+	return fmt.Errorf("%w '%s'", errUnknownFormat, format)
+}
+
+func (r *SolutionsReport) writeText(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	for _, sln := range r.Solutions {
+		bw.WriteString(solving.Solution(sln.Words).String())
+		bw.WriteRune('\n')
+	}
+
+	return bw.Flush()
+}
+
+func (r *SolutionsReport) writeJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(r)
+}
+
+func (r *SolutionsReport) writeCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	cw.Write([]string{"words", "wordCount", "totalChars", "letters"})
+
+	for _, sln := range r.Solutions {
+		cw.Write([]string{
+			strings.Join(sln.Words, " "),
+			strconv.Itoa(sln.WordCount),
+			strconv.Itoa(sln.TotalChars),
+			sln.Letters,
+		})
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// writeReport writes the report to a file named for the puzzle in the
+// output directory, or to stdout if the output directory is "-".
+func writeReport(r *SolutionsReport, cmd SolveCmd, name string) error {
+	ext, err := formatExt(cmd.Format)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Outdir == stdoutName {
+		return r.Write(os.Stdout, cmd.Format)
+	}
+
+	fname := "solutions." + ext
+	if name != "" {
+		fname = fmt.Sprintf("%s-solutions.%s", name, ext)
+	}
+
+	outpath := path.Join(cmd.Outdir, fname)
+
+	if err := makeOutdir(cmd.Outdir); err != nil {
+		return err
+	}
+
+	f, err := os.Create(outpath)
+	if err != nil {
+		return fmt.Errorf("failed to create solutions file: %w", err)
+	}
+
+	defer f.Close()
+
+	log.Info().Str("outpath", outpath).Msg("writing solutions to file")
+
+	return r.Write(f, cmd.Format)
+}
+
+// writeSVGs writes an image of each solution to a file named for the
+// puzzle and the solution's rank. Images go to the current dir instead of
+// stdout.
+func writeSVGs(puzzle *models.Puzzle, solutions []solving.Solution, outdir, name string) error {
+	if len(solutions) == 0 {
+		return nil
+	}
+
+	if outdir == stdoutName {
+		outdir = "."
+	}
+
+	if err := makeOutdir(outdir); err != nil {
+		return err
+	}
+
+	prefix := "solution"
+	if name != "" {
+		prefix = name + "-solution"
+	}
+
+	for i, sln := range solutions {
+		outpath := path.Join(outdir, fmt.Sprintf("%s-%d.svg", prefix, i+1))
+
+		if err := writeSVG(puzzle, sln, outpath); err != nil {
+			return err
+		}
+	}
+
+	log.Info().Int("count", len(solutions)).Str("outdir", outdir).Msg("wrote solution images")
+
+	return nil
+}
+
+func writeSVG(puzzle *models.Puzzle, sln solving.Solution, outpath string) error {
+	f, err := os.Create(outpath)
+	if err != nil {
+		return fmt.Errorf("failed to create SVG file: %w", err)
+	}
+
+	defer f.Close()
+
+	if err := render.SVG(f, puzzle, sln); err != nil {
+		return fmt.Errorf("failed to write SVG file: %w", err)
+	}
+
+	return nil
+}
+
+func makeOutdir(outdir string) error {
+	info, err := os.Stat(outdir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return fmt.Errorf("failed to stat output dir: %w", err)
+		}
+
+		if err = os.Mkdir(outdir, 0750); err != nil {
+			return fmt.Errorf("failed to make output dir: %w", err)
+		}
+	} else if !info.IsDir() {
+		return fmt.Errorf("'%s' is not a dir", outdir)
+	}
+
+	return nil
+}
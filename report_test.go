@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+func newTestReport(t *testing.T) *SolutionsReport {
+	p, err := models.NewPuzzle([]string{"apl", "gnm", "tih", "ord"}, 4)
+	require.NoError(t, err)
+
+	cmd := SolveCmd{MaxBranch: 5, MaxTime: "5s", Strategy: solving.StrategyBestFirst, Workers: 1, Scoring: "weighted"}
+
+	return NewSolutionsReport(p, cmd, []solving.Solution{
+		{"PHANTOM", "MARIGOLD"},
+		{"HOLOGRAM", "MIDPOINT"},
+	}, true)
+}
+
+func TestSolutionsReportJSON(t *testing.T) {
+	var buf bytes.Buffer
+
+	require.NoError(t, newTestReport(t).Write(&buf, formatJSON))
+
+	var report struct {
+		Puzzle struct {
+			Sides    []string `json:"sides"`
+			MaxWords int      `json:"maxWords"`
+		} `json:"puzzle"`
+		Settings  ReportSettings   `json:"settings"`
+		Complete  bool             `json:"complete"`
+		Solutions []SolutionReport `json:"solutions"`
+	}
+
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &report))
+
+	assert.Equal(t, []string{"APL", "GNM", "TIH", "ORD"}, report.Puzzle.Sides)
+	assert.Equal(t, 4, report.Puzzle.MaxWords)
+	assert.Equal(t, solving.StrategyBestFirst, report.Settings.Strategy)
+	assert.True(t, report.Complete)
+	assert.Equal(t, []SolutionReport{
+		{Words: []string{"PHANTOM", "MARIGOLD"}, WordCount: 2, TotalChars: 15, Letters: "ADGHILMNOPRT"},
+		{Words: []string{"HOLOGRAM", "MIDPOINT"}, WordCount: 2, TotalChars: 16, Letters: "ADGHILMNOPRT"},
+	}, report.Solutions)
+}
+
+func TestSolutionsReportCSV(t *testing.T) {
+	var buf bytes.Buffer
+
+	require.NoError(t, newTestReport(t).Write(&buf, formatCSV))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+
+	assert.Equal(t, [][]string{
+		{"words", "wordCount", "totalChars", "letters"},
+		{"PHANTOM MARIGOLD", "2", "15", "ADGHILMNOPRT"},
+		{"HOLOGRAM MIDPOINT", "2", "16", "ADGHILMNOPRT"},
+	}, records)
+}
+
+func TestSolutionsReportUnknownFormat(t *testing.T) {
+	var buf bytes.Buffer
+
+	assert.ErrorIs(t, newTestReport(t).Write(&buf, "xml"), errUnknownFormat)
+}
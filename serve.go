@@ -0,0 +1,133 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/jamestunnell/letter-boxed-solver/server"
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+var errInvalidDict = errors.New("invalid dictionary")
+
+const (
+	readHeaderTimeout = 10 * time.Second
+	readTimeout       = 30 * time.Second
+	idleTimeout       = 2 * time.Minute
+	// writeSlack is added to the max solve time for the write timeout, so
+	// solutions streamed until the time limit can still be sent.
+	writeSlack = 30 * time.Second
+)
+
+func serve(cmd *ServeCmd) error {
+	defaultTime, err := time.ParseDuration(cmd.DefaultTime)
+	if err != nil {
+		return fmt.Errorf("failed to parse default time: %w", err)
+	}
+
+	maxTime, err := time.ParseDuration(cmd.MaxTime)
+	if err != nil {
+		return fmt.Errorf("failed to parse max time: %w", err)
+	}
+
+	progressInterval, err := time.ParseDuration(cmd.Progress)
+	if err != nil {
+		return fmt.Errorf("failed to parse progress interval: %w", err)
+	}
+
+	wordSource, closeWords, err := openWordSource(cmd.WordsCmd)
+	if err != nil {
+		return err
+	}
+
+	// the words are read once up front and shared by all requests
+	allWords := solving.ReadAllWords(wordSource)
+
+	closeWords()
+
+	dicts, err := loadDictionaries(cmd.Dicts)
+	if err != nil {
+		return err
+	}
+
+	puzzlesFS, err := fs.Sub(puzzles, "puzzles")
+	if err != nil {
+		return fmt.Errorf("failed to open built-in puzzles: %w", err)
+	}
+
+	webFS, err := fs.Sub(web, "web")
+	if err != nil {
+		return fmt.Errorf("failed to open web UI files: %w", err)
+	}
+
+	s := server.New(server.Config{
+		Words:            allWords,
+		Dictionaries:     dicts,
+		Puzzles:          puzzlesFS,
+		DefaultTime:      defaultTime,
+		MaxTime:          maxTime,
+		MaxBranch:        cmd.MaxBranch,
+		MaxWorkers:       cmd.MaxWorkers,
+		ProgressInterval: progressInterval,
+		Web:              webFS,
+	})
+
+	log.Info().
+		Str("addr", cmd.Addr).
+		Int("words", len(allWords)).
+		Int("dictionaries", len(dicts)).
+		Float64("defaultTimeSec", defaultTime.Seconds()).
+		Float64("maxTimeSec", maxTime.Seconds()).
+		Int("maxBranch", cmd.MaxBranch).
+		Int("maxWorkers", cmd.MaxWorkers).
+		Msg("serving")
+
+	srv := &http.Server{
+		Addr:              cmd.Addr,
+		Handler:           s,
+		ReadHeaderTimeout: readHeaderTimeout,
+		ReadTimeout:       readTimeout,
+		WriteTimeout:      maxTime + writeSlack,
+		IdleTimeout:       idleTimeout,
+	}
+
+	return srv.ListenAndServe()
+}
+
+// loadDictionaries reads the word lists given as name=path.
+func loadDictionaries(dicts []string) (map[string][]string, error) {
+	loaded := map[string][]string{}
+
+	for _, dict := range dicts {
+		name, fpath, found := strings.Cut(dict, "=")
+		if !found || name == "" || fpath == "" {
+			return nil, fmt.Errorf("%w '%s', expected name=path", errInvalidDict, dict)
+		}
+
+		if name == server.DefaultDictionary {
+			return nil, fmt.Errorf("%w '%s', the name '%s' is reserved for the main word list", errInvalidDict, dict, name)
+		}
+
+		if _, found := loaded[name]; found {
+			return nil, fmt.Errorf("%w '%s', the name '%s' is given more than once", errInvalidDict, dict, name)
+		}
+
+		f, err := os.Open(fpath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open dictionary file: %w", err)
+		}
+
+		loaded[name] = solving.ReadAllWords(solving.NewFileWordSource(f))
+
+		f.Close()
+	}
+
+	return loaded, nil
+}
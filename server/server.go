@@ -0,0 +1,224 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+// Config configures the server. The words are loaded once and shared by
+// every request.
+type Config struct {
+	// Words is the default dictionary, and Dictionaries are others that
+	// requests can pick by name.
+	Words        []string
+	Dictionaries map[string][]string
+	// Puzzles holds puzzle JSON files, which are listed and solved by name.
+	Puzzles fs.FS
+	// DefaultTime is the solve time limit for requests that do not give
+	// one, and MaxTime caps the limit that requests can ask for.
+	DefaultTime time.Duration
+	MaxTime     time.Duration
+	// MaxBranch and MaxWorkers cap the branching and parallel explorers that
+	// requests can ask for. Zero means DefaultMaxBranch and
+	// DefaultMaxWorkers.
+	MaxBranch  int
+	MaxWorkers int
+	// ProgressInterval is the min time between progress events when
+	// streaming.
+	ProgressInterval time.Duration
+	// Web holds the web UI files, which are served from the root. Nil
+	// means there is no web UI.
+	Web fs.FS
+}
+
+// Server handles the HTTP JSON API for solving and verifying puzzles.
+type Server struct {
+	cfg   Config
+	dicts map[string]solving.WordSet
+	mux   *http.ServeMux
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+type PuzzlesResponse struct {
+	Puzzles []string `json:"puzzles"`
+}
+
+type DictionariesResponse struct {
+	Dictionaries []string `json:"dictionaries"`
+}
+
+const (
+	DefaultDictionary = "default"
+	DefaultMaxBranch  = 20
+	DefaultMaxWorkers = 4
+
+	// MaxRequestBytes limits the size of request bodies.
+	MaxRequestBytes = 1 << 20
+
+	puzzleExt = ".json"
+)
+
+var (
+	errNoPuzzle          = errors.New("no puzzle given")
+	errPuzzleMissing     = errors.New("puzzle not found")
+	errUnknownDictionary = errors.New("unknown dictionary")
+)
+
+func New(cfg Config) *Server {
+	if cfg.MaxBranch <= 0 {
+		cfg.MaxBranch = DefaultMaxBranch
+	}
+
+	if cfg.MaxWorkers <= 0 {
+		cfg.MaxWorkers = DefaultMaxWorkers
+	}
+
+	s := &Server{
+		cfg:   cfg,
+		dicts: map[string]solving.WordSet{DefaultDictionary: solving.NewWordSet(cfg.Words)},
+		mux:   http.NewServeMux(),
+	}
+
+	for name, words := range cfg.Dictionaries {
+		s.dicts[name] = solving.NewWordSet(words)
+	}
+
+	s.mux.HandleFunc("POST /solve", s.handleSolve)
+	s.mux.HandleFunc("POST /verify", s.handleVerify)
+	s.mux.HandleFunc("GET /puzzles", s.handlePuzzles)
+	s.mux.HandleFunc("GET /puzzles/{name}/solutions", s.handlePuzzleSolutions)
+	s.mux.HandleFunc("GET /solve/stream", s.handleSolveStream)
+	s.mux.HandleFunc("GET /puzzles/{name}/solutions/stream", s.handlePuzzleSolutionsStream)
+	s.mux.HandleFunc("GET /dictionaries", s.handleDictionaries)
+
+	if cfg.Web != nil {
+		s.mux.Handle("GET /", http.FileServerFS(cfg.Web))
+	}
+
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	log.Debug().Str("method", r.Method).Str("path", r.URL.Path).Msg("handling request")
+
+	r.Body = http.MaxBytesReader(w, r.Body, MaxRequestBytes)
+
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) handlePuzzles(w http.ResponseWriter, _ *http.Request) {
+	names, err := s.puzzleNames()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &PuzzlesResponse{Puzzles: names})
+}
+
+func (s *Server) handleDictionaries(w http.ResponseWriter, _ *http.Request) {
+	names := []string{}
+	for name := range s.dicts {
+		if name != DefaultDictionary {
+			names = append(names, name)
+		}
+	}
+
+	slices.Sort(names)
+
+	writeJSON(w, http.StatusOK, &DictionariesResponse{
+		Dictionaries: append([]string{DefaultDictionary}, names...),
+	})
+}
+
+// dictionary returns the words and word set of the named dictionary, where
+// an empty name is the default.
+func (s *Server) dictionary(name string) ([]string, solving.WordSet, error) {
+	if name == "" || name == DefaultDictionary {
+		return s.cfg.Words, s.dicts[DefaultDictionary], nil
+	}
+
+	words, found := s.cfg.Dictionaries[name]
+	if !found {
+		return nil, nil, fmt.Errorf("%w '%s'", errUnknownDictionary, name)
+	}
+
+	return words, s.dicts[name], nil
+}
+
+func (s *Server) puzzleNames() ([]string, error) {
+	names := []string{}
+
+	if s.cfg.Puzzles == nil {
+		return names, nil
+	}
+
+	entries, err := fs.ReadDir(s.cfg.Puzzles, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read puzzle entries: %w", err)
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() && path.Ext(entry.Name()) == puzzleExt {
+			names = append(names, strings.TrimSuffix(entry.Name(), puzzleExt))
+		}
+	}
+
+	slices.Sort(names)
+
+	return names, nil
+}
+
+// loadPuzzle loads the named puzzle, where the name may leave off the
+// .json extension.
+func (s *Server) loadPuzzle(name string) (*models.Puzzle, error) {
+	if s.cfg.Puzzles == nil {
+		return nil, fmt.Errorf("%w: %s", errPuzzleMissing, name)
+	}
+
+	fname := strings.TrimSuffix(name, puzzleExt) + puzzleExt
+
+	d, err := fs.ReadFile(s.cfg.Puzzles, fname)
+	if errors.Is(err, fs.ErrNotExist) || errors.Is(err, fs.ErrInvalid) {
+		return nil, fmt.Errorf("%w: %s", errPuzzleMissing, name)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read puzzle file: %w", err)
+	}
+
+	var p models.Puzzle
+
+	if err := json.Unmarshal(d, &p); err != nil {
+		return nil, fmt.Errorf("failed to load puzzle %s: %w", name, err)
+	}
+
+	return &p, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Warn().Err(err).Msg("failed to write response")
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, &errorResponse{Error: err.Error()})
+}
@@ -0,0 +1,171 @@
+package server_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+	"github.com/jamestunnell/letter-boxed-solver/server"
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+const testPuzzleJSON = `{"maxWords": 3, "sides": ["abc", "def", "ghi", "jkl"]}`
+
+func TestServerPuzzles(t *testing.T) {
+	rec := doRequest(t, http.MethodGet, "/puzzles", "")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp server.PuzzlesResponse
+
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, []string{"test"}, resp.Puzzles)
+}
+
+func TestServerDictionaries(t *testing.T) {
+	rec := doRequest(t, http.MethodGet, "/dictionaries", "")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp server.DictionariesResponse
+
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, []string{server.DefaultDictionary, "short", "single"}, resp.Dictionaries)
+}
+
+func TestServerWebUI(t *testing.T) {
+	rec := doRequest(t, http.MethodGet, "/", "")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "<html>")
+}
+
+func TestServerSolve(t *testing.T) {
+	body := `{"puzzle": ` + testPuzzleJSON + `, "strategy": "dp"}`
+	rec := doRequest(t, http.MethodPost, "/solve", body)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp server.SolveResponse
+
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.True(t, resp.Complete)
+	assert.Equal(t, []solving.Solution{{"ADGJBEHK", "KCFIL"}}, resp.Solutions)
+}
+
+func TestServerSolveDictionary(t *testing.T) {
+	body := `{"puzzle": ` + testPuzzleJSON + `, "strategy": "dp", "dictionary": "short"}`
+	rec := doRequest(t, http.MethodPost, "/solve", body)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp server.SolveResponse
+
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, []solving.Solution{{"ADGJBEHK", "KCF", "FIL"}}, resp.Solutions)
+}
+
+func TestServerSolveBadRequests(t *testing.T) {
+	testCases := map[string]string{
+		"no puzzle":        `{}`,
+		"invalid JSON":     `{`,
+		"invalid puzzle":   `{"puzzle": {"maxWords": 3, "sides": ["ab"]}}`,
+		"unknown strategy": `{"puzzle": ` + testPuzzleJSON + `, "strategy": "x"}`,
+		"invalid max time": `{"puzzle": ` + testPuzzleJSON + `, "maxTime": "soon"}`,
+		"unknown dict":     `{"puzzle": ` + testPuzzleJSON + `, "dictionary": "x"}`,
+	}
+
+	for name, body := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rec := doRequest(t, http.MethodPost, "/solve", body)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		})
+	}
+}
+
+func TestServerSolveCapsSettings(t *testing.T) {
+	body := `{"puzzle": ` + testPuzzleJSON + `, "maxBranch": 1000000, "workers": 1000000}`
+	rec := doRequest(t, http.MethodPost, "/solve", body)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp server.SolveResponse
+
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.True(t, resp.Complete)
+	assert.Contains(t, resp.Solutions, solving.Solution{"ADGJBEHK", "KCFIL"})
+}
+
+func TestServerRequestTooLarge(t *testing.T) {
+	body := `{"puzzle": ` + testPuzzleJSON + `, "solution": ["` + strings.Repeat("A", server.MaxRequestBytes) + `"]}`
+	rec := doRequest(t, http.MethodPost, "/verify", body)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+}
+
+func TestServerPuzzleSolutions(t *testing.T) {
+	rec := doRequest(t, http.MethodGet, "/puzzles/test/solutions?strategy=exact", "")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp server.SolveResponse
+
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, []solving.Solution{{"ADGJBEHK", "KCFIL"}}, resp.Solutions)
+
+	rec = doRequest(t, http.MethodGet, "/puzzles/missing/solutions", "")
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestServerVerify(t *testing.T) {
+	body := `{"puzzle": ` + testPuzzleJSON + `, "solution": ["adgjbehk", "kcf"]}`
+	rec := doRequest(t, http.MethodPost, "/verify", body)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var result models.CheckResult
+
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&result))
+	assert.False(t, result.Valid)
+	assert.Equal(t, "IL", result.Uncovered)
+}
+
+func doRequest(t *testing.T, method, target, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	newTestServer().ServeHTTP(rec, req)
+
+	return rec
+}
+
+func newTestServer() *server.Server {
+	return server.New(server.Config{
+		Words: []string{"ADGJBEHK", "KCFIL", "KCF", "FIL"},
+		Dictionaries: map[string][]string{
+			"short":  {"ADGJBEHK", "KCF", "FIL"},
+			"single": {"ADGJBEHKCFIL", "ADGJBEHK", "KCFIL"},
+		},
+		Puzzles: fstest.MapFS{
+			"test.json": &fstest.MapFile{Data: []byte(testPuzzleJSON)},
+		},
+		DefaultTime:      time.Second,
+		MaxTime:          time.Second,
+		ProgressInterval: time.Nanosecond,
+		Web: fstest.MapFS{
+			"index.html": &fstest.MapFile{Data: []byte("<html></html>")},
+		},
+	})
+}
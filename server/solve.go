@@ -0,0 +1,256 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+// SolveSettings are the optional solver settings for a solve request.
+// Settings that are left out use the solving defaults.
+type SolveSettings struct {
+	Strategy   string `json:"strategy,omitempty"`
+	MaxTime    string `json:"maxTime,omitempty"`
+	MaxBranch  int    `json:"maxBranch,omitempty"`
+	Workers    int    `json:"workers,omitempty"`
+	Scoring    string `json:"scoring,omitempty"`
+	Dictionary string `json:"dictionary,omitempty"`
+}
+
+type SolveRequest struct {
+	Puzzle *models.Puzzle `json:"puzzle"`
+
+	SolveSettings
+}
+
+type SolveResponse struct {
+	Puzzle    *models.Puzzle     `json:"puzzle"`
+	Solutions []solving.Solution `json:"solutions"`
+	// Complete is false if the time limit cut the search short.
+	Complete   bool    `json:"complete"`
+	ElapsedSec float64 `json:"elapsedSec"`
+}
+
+type VerifyRequest struct {
+	Puzzle     *models.Puzzle `json:"puzzle"`
+	Solution   []string       `json:"solution"`
+	Dictionary string         `json:"dictionary,omitempty"`
+}
+
+var errInvalidSetting = errors.New("invalid setting")
+
+func (s *Server) handleSolve(w http.ResponseWriter, r *http.Request) {
+	var req SolveRequest
+
+	if status, err := decodeRequest(r, &req); err != nil {
+		writeError(w, status, err)
+
+		return
+	}
+
+	if req.Puzzle == nil {
+		writeError(w, http.StatusBadRequest, errNoPuzzle)
+
+		return
+	}
+
+	s.solve(w, r, req.Puzzle, req.SolveSettings)
+}
+
+func (s *Server) handlePuzzleSolutions(w http.ResponseWriter, r *http.Request) {
+	p, err := s.loadPuzzle(r.PathValue("name"))
+	if errors.Is(err, errPuzzleMissing) {
+		writeError(w, http.StatusNotFound, err)
+
+		return
+	} else if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	settings, err := settingsFromQuery(r.URL.Query())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+
+		return
+	}
+
+	s.solve(w, r, p, settings)
+}
+
+func (s *Server) handleVerify(w http.ResponseWriter, r *http.Request) {
+	var req VerifyRequest
+
+	if status, err := decodeRequest(r, &req); err != nil {
+		writeError(w, status, err)
+
+		return
+	}
+
+	if req.Puzzle == nil {
+		writeError(w, http.StatusBadRequest, errNoPuzzle)
+
+		return
+	}
+
+	_, dict, err := s.dictionary(req.Dictionary)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, req.Puzzle.CheckSolution(req.Solution, dict))
+}
+
+// decodeRequest decodes the JSON request body, returning the status to
+// respond with if it fails.
+func decodeRequest(r *http.Request, v any) (int, error) {
+	err := json.NewDecoder(r.Body).Decode(v)
+	if err == nil {
+		return http.StatusOK, nil
+	}
+
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		return http.StatusRequestEntityTooLarge, fmt.Errorf("invalid request: %w", err)
+	}
+
+	return http.StatusBadRequest, fmt.Errorf("invalid request: %w", err)
+}
+
+func (s *Server) solve(w http.ResponseWriter, r *http.Request, p *models.Puzzle, settings SolveSettings) {
+	ctx, cancel, solver, err := s.startSolving(r.Context(), p, settings)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+
+		return
+	}
+
+	defer cancel()
+
+	start := time.Now()
+
+	for range solving.Stream(ctx, solver, solving.StreamOptions{}) {
+	}
+
+	solutions := solving.SolutionsByWordCount{}
+	for _, sln := range solver.GetSolutions() {
+		solutions.Add(sln)
+	}
+
+	writeJSON(w, http.StatusOK, &SolveResponse{
+		Puzzle:     p,
+		Solutions:  solutions.All(),
+		Complete:   solver.IsFinished(),
+		ElapsedSec: time.Since(start).Seconds(),
+	})
+}
+
+// startSolving makes a solver for the puzzle using the chosen dictionary,
+// along with a context that ends at the request's time limit. The max branch
+// and workers are capped like the time limit.
+func (s *Server) startSolving(
+	ctx context.Context,
+	p *models.Puzzle,
+	settings SolveSettings,
+) (context.Context, context.CancelFunc, solving.Stepper, error) {
+	maxTime, err := s.timeLimit(settings.MaxTime)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	opts := solving.DefaultStrategyOptions()
+
+	if settings.Strategy != "" {
+		opts.Strategy = settings.Strategy
+	}
+
+	if settings.MaxBranch > 0 {
+		opts.MaxBranch = settings.MaxBranch
+	}
+
+	if settings.Workers > 0 {
+		opts.Workers = settings.Workers
+	}
+
+	if settings.Scoring != "" {
+		opts.Scoring = settings.Scoring
+	}
+
+	opts.MaxBranch = min(opts.MaxBranch, s.cfg.MaxBranch)
+	opts.Workers = min(opts.Workers, s.cfg.MaxWorkers)
+
+	words, _, err := s.dictionary(settings.Dictionary)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	solver, err := solving.NewStepper(p, solving.NewSliceWordSource(words), opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, maxTime)
+
+	return ctx, cancel, solver, nil
+}
+
+// timeLimit parses the requested time limit, using the default if it is
+// empty and capping it at the max.
+func (s *Server) timeLimit(maxTime string) (time.Duration, error) {
+	if maxTime == "" {
+		return min(s.cfg.DefaultTime, s.cfg.MaxTime), nil
+	}
+
+	d, err := time.ParseDuration(maxTime)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("%w: max time '%s'", errInvalidSetting, maxTime)
+	}
+
+	return min(d, s.cfg.MaxTime), nil
+}
+
+func settingsFromQuery(q url.Values) (SolveSettings, error) {
+	settings := SolveSettings{
+		Strategy:   q.Get("strategy"),
+		MaxTime:    q.Get("maxTime"),
+		Scoring:    q.Get("scoring"),
+		Dictionary: q.Get("dictionary"),
+	}
+
+	atoi := func(name string) (int, error) {
+		str := q.Get(name)
+		if str == "" {
+			return 0, nil
+		}
+
+		n, err := strconv.Atoi(str)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %s '%s'", errInvalidSetting, name, str)
+		}
+
+		return n, nil
+	}
+
+	var err error
+
+	if settings.MaxBranch, err = atoi("maxBranch"); err != nil {
+		return SolveSettings{}, err
+	}
+
+	if settings.Workers, err = atoi("workers"); err != nil {
+		return SolveSettings{}, err
+	}
+
+	return settings, nil
+}
@@ -0,0 +1,221 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+// SolutionEvent is sent as soon as the solver finds a solution.
+type SolutionEvent struct {
+	Words      solving.Solution `json:"words"`
+	StartWord  string           `json:"startWord"`
+	ElapsedSec float64          `json:"elapsedSec"`
+}
+
+// ProgressEvent is sent between solver steps, at most once per progress
+// interval. The explorer counts are only given by the best-first strategy.
+type ProgressEvent struct {
+	ElapsedSec         float64 `json:"elapsedSec"`
+	Solutions          int     `json:"solutions"`
+	BestWordCount      int     `json:"bestWordCount"`
+	ExplorersRemaining int     `json:"explorersRemaining,omitempty"`
+	ExplorersTotal     int     `json:"explorersTotal,omitempty"`
+}
+
+// DoneEvent is the last event sent. Complete is false if the time limit
+// cut the search short.
+type DoneEvent struct {
+	ElapsedSec float64 `json:"elapsedSec"`
+	Solutions  int     `json:"solutions"`
+	Complete   bool    `json:"complete"`
+}
+
+type statsSolver interface {
+	Stats() solving.SolverStats
+}
+
+const (
+	EventSolution = "solution"
+	EventProgress = "progress"
+	EventDone     = "done"
+)
+
+var (
+	errStreamingUnsupported = errors.New("streaming is not supported")
+	errInvalidPuzzleQuery   = errors.New("invalid puzzle query")
+)
+
+// handleSolveStream solves a puzzle given by the sides and maxWords query
+// parameters, e.g. sides=apl,gnm,tih,ord&maxWords=4, streaming the results.
+func (s *Server) handleSolveStream(w http.ResponseWriter, r *http.Request) {
+	p, err := puzzleFromQuery(r.URL.Query())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+
+		return
+	}
+
+	s.streamSolve(w, r, p)
+}
+
+func (s *Server) handlePuzzleSolutionsStream(w http.ResponseWriter, r *http.Request) {
+	p, err := s.loadPuzzle(r.PathValue("name"))
+	if errors.Is(err, errPuzzleMissing) {
+		writeError(w, http.StatusNotFound, err)
+
+		return
+	} else if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	s.streamSolve(w, r, p)
+}
+
+// streamSolve sends server-sent events while the solver runs, until it
+// finishes, the time limit is reached, or the client goes away.
+func (s *Server) streamSolve(w http.ResponseWriter, r *http.Request, p *models.Puzzle) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, errStreamingUnsupported)
+
+		return
+	}
+
+	settings, err := settingsFromQuery(r.URL.Query())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+
+		return
+	}
+
+	ctx, cancel, solver, err := s.startSolving(r.Context(), p, settings)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+
+		return
+	}
+
+	defer cancel()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	send := func(event string, v any) bool {
+		if err := writeEvent(w, event, v); err != nil {
+			log.Debug().Err(err).Msg("failed to send event, stopping")
+
+			return false
+		}
+
+		flusher.Flush()
+
+		return true
+	}
+
+	var (
+		best   solving.Solution
+		sent   int
+		failed bool
+	)
+
+	opts := solving.StreamOptions{
+		ProgressInterval: s.cfg.ProgressInterval,
+		Progress: func(elapsed time.Duration) {
+			// stop the solver if the client cannot be reached
+			if !send(EventProgress, progressEvent(solver, best, sent, elapsed)) {
+				failed = true
+
+				cancel()
+			}
+		},
+	}
+
+	start := time.Now()
+
+	for found := range solving.Stream(ctx, solver, opts) {
+		if best == nil || found.Solution.IsBetter(best) {
+			best = found.Solution
+		}
+
+		ok := send(EventSolution, &SolutionEvent{
+			Words:      found.Solution,
+			StartWord:  found.StartWord,
+			ElapsedSec: found.Elapsed.Seconds(),
+		})
+		if !ok {
+			return
+		}
+
+		sent++
+	}
+
+	// the client is gone, so there is no one to tell
+	if failed || r.Context().Err() != nil {
+		return
+	}
+
+	send(EventDone, &DoneEvent{
+		ElapsedSec: time.Since(start).Seconds(),
+		Solutions:  sent,
+		Complete:   solver.IsFinished(),
+	})
+}
+
+// progressEvent reports the number of solutions sent so far.
+func progressEvent(solver solving.Stepper, best solving.Solution, sent int, elapsed time.Duration) *ProgressEvent {
+	e := &ProgressEvent{
+		ElapsedSec:    elapsed.Seconds(),
+		Solutions:     sent,
+		BestWordCount: len(best),
+	}
+
+	if s, ok := solver.(statsSolver); ok {
+		stats := s.Stats()
+
+		e.ExplorersRemaining = stats.ExplorersRemaining
+		e.ExplorersTotal = stats.ExplorersTotal
+	}
+
+	return e
+}
+
+func writeEvent(w http.ResponseWriter, event string, v any) error {
+	d, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, d)
+
+	return err
+}
+
+func puzzleFromQuery(q url.Values) (*models.Puzzle, error) {
+	sides := strings.Split(q.Get("sides"), ",")
+
+	maxWords, err := strconv.Atoi(q.Get("maxWords"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: maxWords '%s'", errInvalidPuzzleQuery, q.Get("maxWords"))
+	}
+
+	p, err := models.NewPuzzle(sides, maxWords)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidPuzzleQuery, err)
+	}
+
+	return p, nil
+}
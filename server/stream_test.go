@@ -0,0 +1,150 @@
+package server_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jamestunnell/letter-boxed-solver/server"
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+type testEvent struct {
+	name string
+	data string
+}
+
+func TestServerSolveStream(t *testing.T) {
+	rec := doRequest(t, http.MethodGet, "/solve/stream?sides=abc,def,ghi,jkl&maxWords=3", "")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+
+	events := readEvents(t, rec.Body)
+	names := map[string]int{}
+
+	for _, e := range events {
+		names[e.name]++
+	}
+
+	assert.Positive(t, names[server.EventSolution])
+	assert.Positive(t, names[server.EventProgress])
+	assert.Equal(t, 1, names[server.EventDone])
+
+	last := events[len(events)-1]
+
+	require.Equal(t, server.EventDone, last.name)
+
+	var done server.DoneEvent
+
+	require.NoError(t, json.Unmarshal([]byte(last.data), &done))
+	assert.True(t, done.Complete)
+	assert.Equal(t, names[server.EventSolution], done.Solutions)
+}
+
+func TestServerSolveStreamSingleWord(t *testing.T) {
+	rec := doRequest(t, http.MethodGet, "/solve/stream?sides=abc,def,ghi,jkl&maxWords=3&dictionary=single", "")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	events := readEvents(t, rec.Body)
+	slns := []solving.Solution{}
+
+	for _, e := range events {
+		if e.name != server.EventSolution {
+			continue
+		}
+
+		var sln server.SolutionEvent
+
+		require.NoError(t, json.Unmarshal([]byte(e.data), &sln))
+
+		slns = append(slns, sln.Words)
+	}
+
+	assert.Contains(t, slns, solving.Solution{"ADGJBEHKCFIL"})
+
+	var done server.DoneEvent
+
+	require.NoError(t, json.Unmarshal([]byte(events[len(events)-1].data), &done))
+	assert.Equal(t, len(slns), done.Solutions)
+}
+
+func TestServerPuzzleSolutionsStream(t *testing.T) {
+	rec := doRequest(t, http.MethodGet, "/puzzles/test/solutions/stream?strategy=dp", "")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	slns := []solving.Solution{}
+
+	for _, e := range readEvents(t, rec.Body) {
+		if e.name != server.EventSolution {
+			continue
+		}
+
+		var sln server.SolutionEvent
+
+		require.NoError(t, json.Unmarshal([]byte(e.data), &sln))
+
+		slns = append(slns, sln.Words)
+	}
+
+	assert.Equal(t, []solving.Solution{{"ADGJBEHK", "KCFIL"}}, slns)
+}
+
+func TestServerSolveStreamDisconnected(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	cancel()
+
+	req := httptest.NewRequestWithContext(ctx, http.MethodGet, "/puzzles/test/solutions/stream", nil)
+	rec := httptest.NewRecorder()
+
+	newTestServer().ServeHTTP(rec, req)
+
+	assert.Empty(t, readEvents(t, rec.Body))
+}
+
+func TestServerSolveStreamBadQuery(t *testing.T) {
+	rec := doRequest(t, http.MethodGet, "/solve/stream?sides=abc,def&maxWords=3", "")
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = doRequest(t, http.MethodGet, "/solve/stream?sides=abc,def,ghi", "")
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func readEvents(t *testing.T, r io.Reader) []testEvent {
+	t.Helper()
+
+	events := []testEvent{}
+	current := testEvent{}
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		switch {
+		case line == "":
+			events = append(events, current)
+			current = testEvent{}
+		case strings.HasPrefix(line, "event: "):
+			current.name = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			current.data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+
+	require.NoError(t, scanner.Err())
+
+	return events
+}
@@ -0,0 +1,102 @@
+package solving
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+)
+
+// Constraints are words that solutions must use or avoid. Empty fields do
+// not constrain solutions.
+type Constraints struct {
+	Required  []string `json:"required,omitempty"`
+	Forbidden []string `json:"forbidden,omitempty"`
+	StartWord string   `json:"startWord,omitempty"`
+	EndWord   string   `json:"endWord,omitempty"`
+}
+
+var ErrInvalidConstraints = errors.New("invalid constraints")
+
+func (c Constraints) IsEmpty() bool {
+	return len(c.Required) == 0 && len(c.Forbidden) == 0 && c.StartWord == "" && c.EndWord == ""
+}
+
+// Validate checks that the constraints could be met by a solution to the
+// puzzle. The words are uppercased before checking.
+func (c Constraints) Validate(p *models.Puzzle) error {
+	c = c.normalized()
+
+	anchors := c.anchors()
+
+	for _, word := range anchors {
+		if !p.IsWordAllowed(word) {
+			return fmt.Errorf("%w: %s is not allowed by the puzzle", ErrInvalidConstraints, word)
+		}
+
+		if c.IsForbidden(word) {
+			return fmt.Errorf("%w: %s is both needed and forbidden", ErrInvalidConstraints, word)
+		}
+	}
+
+	if len(anchors) > p.GetMaxWords() {
+		return fmt.Errorf(
+			"%w: %d words are needed but the max is %d",
+			ErrInvalidConstraints, len(anchors), p.GetMaxWords())
+	}
+
+	return nil
+}
+
+// IsForbidden returns true if the word is forbidden. The word should be
+// uppercase.
+func (c Constraints) IsForbidden(word string) bool {
+	return slices.Contains(c.Forbidden, word)
+}
+
+// IsSatisfiedBy returns true if the solution meets all of the constraints.
+func (c Constraints) IsSatisfiedBy(sln Solution) bool {
+	if len(sln) == 0 {
+		return c.IsEmpty()
+	}
+
+	if c.StartWord != "" && sln[0] != c.StartWord {
+		return false
+	}
+
+	if c.EndWord != "" && sln[len(sln)-1] != c.EndWord {
+		return false
+	}
+
+	for _, word := range c.Required {
+		if !slices.Contains(sln, word) {
+			return false
+		}
+	}
+
+	return !slices.ContainsFunc(sln, c.IsForbidden)
+}
+
+func (c Constraints) normalized() Constraints {
+	return Constraints{
+		Required:  upperAll(c.Required),
+		Forbidden: upperAll(c.Forbidden),
+		StartWord: strings.ToUpper(c.StartWord),
+		EndWord:   strings.ToUpper(c.EndWord),
+	}
+}
+
+// anchors returns the distinct words that every solution must use.
+func (c Constraints) anchors() []string {
+	anchors := []string{}
+
+	for _, word := range append([]string{c.StartWord, c.EndWord}, c.Required...) {
+		if word != "" && !slices.Contains(anchors, word) {
+			anchors = append(anchors, word)
+		}
+	}
+
+	return anchors
+}
@@ -0,0 +1,55 @@
+package solving_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+func TestConstraintsIsSatisfiedBy(t *testing.T) {
+	sln := solving.Solution{"PHANTOM", "MOLD", "DIRIGO"}
+
+	assert.True(t, solving.Constraints{}.IsSatisfiedBy(sln))
+	assert.True(t, solving.Constraints{Required: []string{"MOLD"}, StartWord: "PHANTOM", EndWord: "DIRIGO"}.IsSatisfiedBy(sln))
+	assert.False(t, solving.Constraints{Required: []string{"MARIGOLD"}}.IsSatisfiedBy(sln))
+	assert.False(t, solving.Constraints{Forbidden: []string{"MOLD"}}.IsSatisfiedBy(sln))
+	assert.False(t, solving.Constraints{StartWord: "MOLD"}.IsSatisfiedBy(sln))
+	assert.False(t, solving.Constraints{EndWord: "MOLD"}.IsSatisfiedBy(sln))
+}
+
+func TestConstraintsValidate(t *testing.T) {
+	p := newTestPuzzle()
+
+	assert.NoError(t, solving.Constraints{}.Validate(p))
+	assert.NoError(t, solving.Constraints{Required: []string{"kcf"}, Forbidden: []string{"fil"}}.Validate(p))
+
+	testCases := map[string]solving.Constraints{
+		"not allowed":        {Required: []string{"AB"}},
+		"needed + forbidden": {StartWord: "KCF", Forbidden: []string{"kcf"}},
+		"too many":           {Required: []string{"KCF", "FIL", "KCFIL"}, StartWord: "ADGJBEHK"},
+	}
+
+	for name, c := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, c.Validate(p), solving.ErrInvalidConstraints)
+		})
+	}
+}
+
+func TestNewStepperConstraints(t *testing.T) {
+	opts := solving.DefaultStrategyOptions()
+
+	opts.Constraints = solving.Constraints{Required: []string{"FIL"}}
+
+	_, err := solving.NewStepper(newTestPuzzle(), newTestWordSource(), opts)
+
+	assert.NoError(t, err)
+
+	opts.Strategy = solving.StrategyDP
+
+	_, err = solving.NewStepper(newTestPuzzle(), newTestWordSource(), opts)
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,78 @@
+package solving
+
+import (
+	"github.com/jamestunnell/letter-boxed-solver/models"
+	"github.com/jamestunnell/letter-boxed-solver/util"
+)
+
+// CountSolutions counts the word chains that first cover every puzzle letter
+// with each word count, up to the max count. Every word in a counted chain
+// adds at least one new letter, so the counts are not inflated by padding
+// chains with useless words. Counts are indexed by word count, so the
+// returned slice has length maxWordCount+1.
+func CountSolutions(p *models.Puzzle, wordSource WordSource, maxWordCount int) []int {
+	allowedWords := loadWords(wordSource, p.IsWordAllowed)
+	infos := util.Map(allowedWords, NewWordInfo)
+	masks, fullMask := letterMasks(p, infos)
+	wordMapping := NewWordMapping(infos)
+	chaining := p.GetRules().IsChainingRequired()
+	counts := make([]int, maxWordCount+1)
+
+	if maxWordCount < 1 {
+		return counts
+	}
+
+	stateLetter := func(info *WordInfo) rune {
+		if !chaining {
+			return 0
+		}
+
+		return info.LastLetter
+	}
+
+	chainCounts := map[dpState]int{}
+
+	for _, info := range infos {
+		state := dpState{last: stateLetter(info), mask: masks[info]}
+
+		chainCounts[state]++
+	}
+
+	for wordCount := 1; ; wordCount++ {
+		nextCounts := map[dpState]int{}
+
+		for state, count := range chainCounts {
+			if state.mask == fullMask {
+				counts[wordCount] += count
+
+				continue
+			}
+
+			if wordCount == maxWordCount {
+				continue
+			}
+
+			next := infos
+			if chaining {
+				next = wordMapping.WordsWithFirstLetter(state.last)
+			}
+
+			for _, info := range next {
+				mask := state.mask | masks[info]
+				if mask == state.mask {
+					continue
+				}
+
+				nextCounts[dpState{last: stateLetter(info), mask: mask}] += count
+			}
+		}
+
+		if wordCount == maxWordCount || len(nextCounts) == 0 {
+			break
+		}
+
+		chainCounts = nextCounts
+	}
+
+	return counts
+}
@@ -0,0 +1,15 @@
+package solving_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+func TestCountSolutions(t *testing.T) {
+	counts := solving.CountSolutions(newTestPuzzle(), newTestWordSource(), 3)
+
+	assert.Equal(t, []int{0, 0, 1, 1}, counts)
+}
@@ -0,0 +1,264 @@
+package solving
+
+import (
+	"context"
+	"slices"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+	"github.com/jamestunnell/letter-boxed-solver/util"
+)
+
+// DPSolver does a breadth-first search over (last letter, covered letters)
+// states, where covered letters are tracked as a bitmask over the puzzle
+// letters. Each step advances the search by one word. The first level that
+// reaches a fully-covered state yields all of the shortest solutions.
+//
+// Chains that repeat a word are dropped unless the rules allow word reuse.
+// States do not track which words were used, so a state first reached only
+// by chains with repeats may be needed again at a later level. When reuse is
+// not allowed, states are therefore kept per level rather than only at the
+// level they are first reached.
+type DPSolver struct {
+	puzzle      *models.Puzzle
+	infos       []*WordInfo
+	wordMapping *WordMapping
+	masks       map[*WordInfo]uint64
+	fullMask    uint64
+	level       int
+	frontier    []dpState
+	seen        map[dpState]struct{}
+	edges       map[dpNode][]dpEdge
+	finished    bool
+	solutions   []Solution
+	start       Solution
+	startState  dpState
+}
+
+type dpState struct {
+	last rune
+	mask uint64
+}
+
+// dpNode is a state reached at a given level.
+type dpNode struct {
+	level int
+	state dpState
+}
+
+type dpEdge struct {
+	prev  dpState
+	word  string
+	first bool
+}
+
+func NewDPSolver(p *models.Puzzle, wordSource WordSource) *DPSolver {
+	allowedWords := loadWords(wordSource, p.IsWordAllowed)
+	infos := util.Map(allowedWords, NewWordInfo)
+	masks, fullMask := letterMasks(p, infos)
+
+	return &DPSolver{
+		puzzle:      p,
+		infos:       infos,
+		wordMapping: NewWordMapping(infos),
+		masks:       masks,
+		fullMask:    fullMask,
+		level:       0,
+		frontier:    []dpState{},
+		seen:        map[dpState]struct{}{},
+		edges:       map[dpNode][]dpEdge{},
+		finished:    len(infos) == 0 || p.GetMaxWords() < 1,
+		solutions:   []Solution{},
+	}
+}
+
+// NewDPSolverFrom makes a solver that only finds the shortest solutions
+// that begin with the given start words. The start words are assumed to be
+// a valid chain.
+func NewDPSolverFrom(p *models.Puzzle, wordSource WordSource, start Solution) *DPSolver {
+	s := NewDPSolver(p, wordSource)
+
+	if len(start) == 0 {
+		return s
+	}
+
+	startInfos := util.Map(start, NewWordInfo)
+	startMasks, _ := letterMasks(p, startInfos)
+	last := startInfos[len(startInfos)-1]
+	state := dpState{last: s.stateLetter(last)}
+
+	for _, info := range startInfos {
+		state.mask |= startMasks[info]
+	}
+
+	s.start = slices.Clone(start)
+	s.startState = state
+	s.level = len(start)
+	s.frontier = []dpState{state}
+	s.seen[state] = struct{}{}
+
+	if state.mask == s.fullMask {
+		s.solutions = []Solution{s.start}
+	}
+
+	s.finished = s.finished || len(s.solutions) > 0 || s.level >= p.GetMaxWords()
+
+	return s
+}
+
+// letterMasks returns a bitmask of the puzzle letters in each word, along
+// with the mask of all puzzle letters.
+func letterMasks(p *models.Puzzle, infos []*WordInfo) (map[*WordInfo]uint64, uint64) {
+	letterBits := map[rune]uint64{}
+
+	p.GetLetterSet().EachRune(func(r rune) {
+		letterBits[r] = 1 << len(letterBits)
+	})
+
+	fullMask := uint64(0)
+	for _, bit := range letterBits {
+		fullMask |= bit
+	}
+
+	masks := map[*WordInfo]uint64{}
+	for _, info := range infos {
+		info.Letters.EachRune(func(r rune) {
+			masks[info] |= letterBits[r]
+		})
+	}
+
+	return masks, fullMask
+}
+
+func (s *DPSolver) IsFinished() bool {
+	return s.finished
+}
+
+func (s *DPSolver) GetSolutions() []Solution {
+	return s.solutions
+}
+
+// Step extends every state in the search frontier by one word. If the
+// context is done, the level is abandoned and the next step retries it.
+func (s *DPSolver) Step(ctx context.Context) []FoundSolution {
+	if s.finished {
+		return []FoundSolution{}
+	}
+
+	s.level++
+
+	allowReuse := s.puzzle.GetRules().AllowWordReuse
+	edges := map[dpState][]dpEdge{}
+
+	frontier := []dpState{}
+	addEdge := func(state dpState, edge dpEdge) {
+		// With reuse, a state reached again at a later level cannot lead to
+		// a shorter solution, so only the first level is kept.
+		if _, found := s.seen[state]; found && allowReuse {
+			return
+		}
+
+		if _, found := edges[state]; !found {
+			frontier = append(frontier, state)
+		}
+
+		edges[state] = append(edges[state], edge)
+	}
+
+	if s.level == 1 {
+		for _, info := range s.infos {
+			state := dpState{last: s.stateLetter(info), mask: s.masks[info]}
+
+			addEdge(state, dpEdge{word: info.Word, first: true})
+		}
+	} else {
+		for _, prev := range s.frontier {
+			if ctx.Err() != nil {
+				s.level--
+
+				return []FoundSolution{}
+			}
+
+			for _, info := range s.nextWords(prev) {
+				state := dpState{last: s.stateLetter(info), mask: prev.mask | s.masks[info]}
+
+				addEdge(state, dpEdge{prev: prev, word: info.Word})
+			}
+		}
+	}
+
+	for state, stateEdges := range edges {
+		s.seen[state] = struct{}{}
+		s.edges[dpNode{level: s.level, state: state}] = stateEdges
+	}
+
+	s.frontier = frontier
+
+	log.Debug().
+		Int("level", s.level).
+		Int("states", len(frontier)).
+		Msg("expanded search level")
+
+	for _, state := range frontier {
+		if state.mask != s.fullMask {
+			continue
+		}
+
+		s.solutions = append(s.solutions, s.chains(dpNode{level: s.level, state: state})...)
+	}
+
+	if len(s.solutions) > 0 || len(frontier) == 0 || s.level >= s.puzzle.GetMaxWords() {
+		s.finished = true
+	}
+
+	return util.Map(s.solutions, func(sln Solution) FoundSolution {
+		return FoundSolution{Solution: sln, StartWord: sln[0]}
+	})
+}
+
+// stateLetter returns the letter a chain ending with the word is tracked
+// by. Without chaining, the last letter does not matter so it is ignored.
+func (s *DPSolver) stateLetter(info *WordInfo) rune {
+	if !s.puzzle.GetRules().IsChainingRequired() {
+		return 0
+	}
+
+	return info.LastLetter
+}
+
+func (s *DPSolver) nextWords(state dpState) []*WordInfo {
+	if !s.puzzle.GetRules().IsChainingRequired() {
+		return s.infos
+	}
+
+	return s.wordMapping.WordsWithFirstLetter(state.last)
+}
+
+// chains rebuilds the word chains that lead to the node, leaving out chains
+// that repeat a word unless the rules allow word reuse.
+func (s *DPSolver) chains(node dpNode) []Solution {
+	if s.start != nil && node.level == len(s.start) && node.state == s.startState {
+		return []Solution{slices.Clone(s.start)}
+	}
+
+	chains := []Solution{}
+
+	for _, edge := range s.edges[node] {
+		if edge.first {
+			chains = append(chains, Solution{edge.word})
+
+			continue
+		}
+
+		for _, prefix := range s.chains(dpNode{level: node.level - 1, state: edge.prev}) {
+			if !s.puzzle.GetRules().AllowWordReuse && slices.Contains(prefix, edge.word) {
+				continue
+			}
+
+			chains = append(chains, append(prefix, edge.word))
+		}
+	}
+
+	return chains
+}
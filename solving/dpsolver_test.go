@@ -0,0 +1,59 @@
+package solving_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+func TestDPSolver(t *testing.T) {
+	s := solving.NewDPSolver(newTestPuzzle(), newTestWordSource())
+
+	for !s.IsFinished() {
+		s.Step(context.Background())
+	}
+
+	assert.Equal(t, []solving.Solution{{"ADGJBEHK", "KCFIL"}}, s.GetSolutions())
+}
+
+func TestDPSolverUnchained(t *testing.T) {
+	p := newTestPuzzle()
+
+	require.NoError(t, p.SetRules(models.Rules{AllowUnchained: true}))
+
+	ws := &testWordSource{words: []string{"ADGJBEHK", "CFIL", "FIL"}}
+	s := solving.NewDPSolver(p, ws)
+
+	for !s.IsFinished() {
+		s.Step(context.Background())
+	}
+
+	assert.ElementsMatch(t, []solving.Solution{
+		{"ADGJBEHK", "CFIL"},
+		{"CFIL", "ADGJBEHK"},
+	}, s.GetSolutions())
+}
+
+func TestDPSolverStateFirstReachedWithRepeat(t *testing.T) {
+	p, err := models.NewPuzzle([]string{"abc", "def", "ghi", "jkl"}, 6)
+	require.NoError(t, err)
+
+	// JDA, ADG, GEA, ADG, GBKCHFLI is the only 5-word chain that covers
+	// every letter, and it repeats ADG
+	ws := &testWordSource{words: []string{"JDA", "ADG", "GEA", "AHL", "LDG", "GBKCHFLI"}}
+	s := solving.NewDPSolver(p, ws)
+
+	for !s.IsFinished() {
+		s.Step(context.Background())
+	}
+
+	assert.ElementsMatch(t, []solving.Solution{
+		{"JDA", "ADG", "GEA", "AHL", "LDG", "GBKCHFLI"},
+		{"JDA", "AHL", "LDG", "GEA", "ADG", "GBKCHFLI"},
+	}, s.GetSolutions())
+}
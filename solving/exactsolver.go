@@ -0,0 +1,141 @@
+package solving
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+	"github.com/jamestunnell/letter-boxed-solver/util"
+)
+
+// ExactSolver exhaustively searches word chains of increasing length
+// (iterative deepening) without any branch limit. Once a chain length
+// produces solutions the search stops, so the solutions found are every
+// minimum-word-count solution.
+type ExactSolver struct {
+	puzzle      *models.Puzzle
+	infos       []*WordInfo
+	wordMapping *WordMapping
+	depth       int
+	next        int
+	finished    bool
+	solutions   []Solution
+	existing    map[uint64]struct{}
+}
+
+func NewExactSolver(p *models.Puzzle, wordSource WordSource) *ExactSolver {
+	allowedWords := loadWords(wordSource, p.IsWordAllowed)
+	infos := util.Map(allowedWords, NewWordInfo)
+
+	return &ExactSolver{
+		puzzle:      p,
+		infos:       infos,
+		wordMapping: NewWordMapping(infos),
+		depth:       1,
+		next:        0,
+		finished:    len(infos) == 0 || p.GetMaxWords() < 1,
+		solutions:   []Solution{},
+		existing:    map[uint64]struct{}{},
+	}
+}
+
+// IsFinished returns true once the search space has been fully covered.
+// If solving stops before this returns true, the search was cut short and
+// shorter or additional solutions may exist.
+func (s *ExactSolver) IsFinished() bool {
+	return s.finished
+}
+
+func (s *ExactSolver) GetSolutions() []Solution {
+	return s.solutions
+}
+
+// GetDepth returns the solution word count currently being searched.
+func (s *ExactSolver) GetDepth() int {
+	return s.depth
+}
+
+// Step searches all chains of the current depth that begin with the next
+// start word. If the context is done, the search stops early and the same
+// start word will be searched again by the next step.
+func (s *ExactSolver) Step(ctx context.Context) []FoundSolution {
+	if s.finished {
+		return []FoundSolution{}
+	}
+
+	start := s.infos[s.next]
+	prevCount := len(s.solutions)
+
+	s.search(ctx, []*WordInfo{start}, start.Letters)
+
+	found := util.Map(s.solutions[prevCount:], func(sln Solution) FoundSolution {
+		return FoundSolution{Solution: sln, StartWord: start.Word}
+	})
+
+	if ctx.Err() != nil {
+		return found
+	}
+
+	s.next++
+
+	if s.next < len(s.infos) {
+		return found
+	}
+
+	if len(s.solutions) > 0 || s.depth >= s.puzzle.GetMaxWords() {
+		s.finished = true
+
+		return found
+	}
+
+	log.Debug().Int("depth", s.depth).Msg("no solutions at depth, going deeper")
+
+	s.depth++
+	s.next = 0
+
+	return found
+}
+
+func (s *ExactSolver) search(
+	ctx context.Context,
+	current []*WordInfo,
+	totalLetters models.LetterSet,
+) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	// No shorter chain solves the puzzle (otherwise the search would have
+	// stopped at a smaller depth), so only full-depth chains need checking.
+	if len(current) == s.depth {
+		if s.puzzle.DoLettersSolve(totalLetters) {
+			s.addSolution(util.Map(current, getWord))
+		}
+
+		return
+	}
+
+	last := current[len(current)-1]
+	rules := s.puzzle.GetRules()
+
+	for _, next := range s.wordMapping.NextWords(last, rules.IsChainingRequired()) {
+		if !rules.AllowWordReuse && util.Any(current, func(info *WordInfo) bool {
+			return info.Word == next.Word
+		}) {
+			continue
+		}
+
+		s.search(ctx, append(current, next), totalLetters.Or(next.Letters))
+	}
+}
+
+func (s *ExactSolver) addSolution(sln Solution) {
+	hash := sln.Hash64()
+	if _, found := s.existing[hash]; found {
+		return
+	}
+
+	s.solutions = append(s.solutions, sln)
+	s.existing[hash] = struct{}{}
+}
@@ -0,0 +1,85 @@
+package solving_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+type testWordSource struct {
+	words []string
+}
+
+func (ws *testWordSource) NextWord() (string, bool) {
+	if len(ws.words) == 0 {
+		return "", false
+	}
+
+	word := ws.words[0]
+	ws.words = ws.words[1:]
+
+	return word, true
+}
+
+func newTestPuzzle() *models.Puzzle {
+	p, err := models.NewPuzzle([]string{"abc", "def", "ghi", "jkl"}, 3)
+	if err != nil {
+		panic(err)
+	}
+
+	return p
+}
+
+func newTestWordSource() solving.WordSource {
+	return &testWordSource{
+		words: []string{"ADGJBEHK", "KCFIL", "KCF", "FIL", "AB", "BAD"},
+	}
+}
+
+func TestExactSolver(t *testing.T) {
+	s := solving.NewExactSolver(newTestPuzzle(), newTestWordSource())
+
+	for !s.IsFinished() {
+		s.Step(context.Background())
+	}
+
+	require.Len(t, s.GetSolutions(), 1)
+	assert.Equal(t, solving.Solution{"ADGJBEHK", "KCFIL"}, s.GetSolutions()[0])
+	assert.Equal(t, 2, s.GetDepth())
+}
+
+func TestExactSolverCanceled(t *testing.T) {
+	s := solving.NewExactSolver(newTestPuzzle(), newTestWordSource())
+	ctx, cancel := context.WithCancel(context.Background())
+
+	cancel()
+
+	s.Step(ctx)
+
+	assert.False(t, s.IsFinished())
+	assert.Equal(t, 1, s.GetDepth())
+	assert.Empty(t, s.GetSolutions())
+}
+
+func TestExactSolverUnchained(t *testing.T) {
+	p := newTestPuzzle()
+
+	require.NoError(t, p.SetRules(models.Rules{AllowUnchained: true}))
+
+	ws := &testWordSource{words: []string{"ADGJBEHK", "CFIL", "FIL"}}
+	s := solving.NewExactSolver(p, ws)
+
+	for !s.IsFinished() {
+		s.Step(context.Background())
+	}
+
+	assert.ElementsMatch(t, []solving.Solution{
+		{"ADGJBEHK", "CFIL"},
+		{"CFIL", "ADGJBEHK"},
+	}, s.GetSolutions())
+}
@@ -1,6 +1,7 @@
 package solving
 
 import (
+	"context"
 	"slices"
 	"sort"
 
@@ -22,6 +23,11 @@ type Explorer struct {
 	wordMapping *WordMapping
 	maxBranch   int
 	scoring     Scoring
+	// keepWords are always branched to, even when they would be pruned by
+	// the max branch, so words that solutions must use are not lost.
+	keepWords []string
+
+	nodesExpanded int
 }
 
 func NewExploreResults() *ExploreResults {
@@ -50,9 +56,13 @@ func NewExplorer(
 	return e
 }
 
-func (e *Explorer) Explore() []Solution {
-	leftResults := e.exploreLeft()
-	rightResults := e.exploreRight()
+// Explore finds solutions around the start word. If the context is done,
+// exploring stops early and the solutions found so far are returned.
+func (e *Explorer) Explore(ctx context.Context) []Solution {
+	e.nodesExpanded = 0
+
+	leftResults := e.exploreLeft(ctx)
+	rightResults := e.exploreRight(ctx)
 	complete := []Solution{}
 
 	for _, backwardsSln := range leftResults.Complete {
@@ -77,18 +87,24 @@ func (e *Explorer) Explore() []Solution {
 	return complete
 }
 
-func (e *Explorer) exploreLeft() *ExploreResults {
+// NodesExpanded returns the number of word chains visited by the last
+// Explore.
+func (e *Explorer) NodesExpanded() int {
+	return e.nodesExpanded
+}
+
+func (e *Explorer) exploreLeft(ctx context.Context) *ExploreResults {
 	results := NewExploreResults()
 
-	e.explore([]*WordInfo{e.WordInfo}, e.Letters, e.getLeftSubwords, results)
+	e.explore(ctx, []*WordInfo{e.WordInfo}, e.Letters, e.getLeftSubwords, results)
 
 	return results
 }
 
-func (e *Explorer) exploreRight() *ExploreResults {
+func (e *Explorer) exploreRight(ctx context.Context) *ExploreResults {
 	results := NewExploreResults()
 
-	e.explore([]*WordInfo{e.WordInfo}, e.Letters, e.getRightSubwords, results)
+	e.explore(ctx, []*WordInfo{e.WordInfo}, e.Letters, e.getRightSubwords, results)
 
 	return results
 }
@@ -121,7 +137,7 @@ func (e *Explorer) getLeftSubwords(
 	current *WordInfo,
 	totalLetters models.LetterSet,
 ) []*WordInfo {
-	subWords := e.wordMapping.WordsWithLastLetter(current.FirstLetter)
+	subWords := e.wordMapping.PrevWords(current, e.puzzle.GetRules().IsChainingRequired())
 
 	if len(subWords) > e.maxBranch {
 		subWords = e.reduceSubwords(subWords, totalLetters)
@@ -134,7 +150,7 @@ func (e *Explorer) getRightSubwords(
 	current *WordInfo,
 	totalLetters models.LetterSet,
 ) []*WordInfo {
-	subWords := e.wordMapping.WordsWithFirstLetter(current.LastLetter)
+	subWords := e.wordMapping.NextWords(current, e.puzzle.GetRules().IsChainingRequired())
 
 	if len(subWords) > e.maxBranch {
 		subWords = e.reduceSubwords(subWords, totalLetters)
@@ -147,6 +163,9 @@ func (e *Explorer) reduceSubwords(
 	subWords []*WordInfo,
 	totalLetters models.LetterSet,
 ) []*WordInfo {
+	// the subwords are shared by the word mapping, so sort a copy
+	subWords = slices.Clone(subWords)
+
 	sortByScoreDesc := &SortWordsByScoreDesc{
 		SortWordsByScore: &SortWordsByScore{
 			Infos: subWords,
@@ -160,15 +179,30 @@ func (e *Explorer) reduceSubwords(
 
 	sort.Sort(sortByScoreDesc)
 
-	return subWords[:e.maxBranch]
+	reduced := slices.Clip(subWords[:e.maxBranch])
+
+	for _, info := range subWords[e.maxBranch:] {
+		if slices.Contains(e.keepWords, info.Word) {
+			reduced = append(reduced, info)
+		}
+	}
+
+	return reduced
 }
 
 func (e *Explorer) explore(
+	ctx context.Context,
 	current []*WordInfo,
 	totalLetters models.LetterSet,
 	getSubWords func(*WordInfo, models.LetterSet) []*WordInfo,
 	results *ExploreResults,
 ) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	e.nodesExpanded++
+
 	if e.puzzle.DoLettersSolve(totalLetters) {
 		results.AddComplete(util.Map(current, getWord))
 
@@ -184,7 +218,7 @@ func (e *Explorer) explore(
 	subWords := getSubWords(current[len(current)-1], totalLetters)
 	for _, subWord := range subWords {
 		// detect cycle
-		if util.Any(current, func(info *WordInfo) bool {
+		if !e.puzzle.GetRules().AllowWordReuse && util.Any(current, func(info *WordInfo) bool {
 			return info.Word == subWord.Word
 		}) {
 			continue
@@ -192,7 +226,7 @@ func (e *Explorer) explore(
 
 		newTotalLetters := totalLetters.Or(subWord.Letters)
 
-		e.explore(append(current, subWord), newTotalLetters, getSubWords, results)
+		e.explore(ctx, append(current, subWord), newTotalLetters, getSubWords, results)
 	}
 }
 
@@ -0,0 +1,81 @@
+package solving
+
+import (
+	"slices"
+	"strings"
+)
+
+// SolutionFilter selects solutions for output. Zero values turn each limit
+// off.
+type SolutionFilter struct {
+	// Top keeps only the first solutions that pass the other filters.
+	Top           int
+	MaxWords      int
+	MinTotalChars int
+	MaxTotalChars int
+	// MustInclude words must all be in a solution, and Exclude words must
+	// not be in it.
+	MustInclude []string
+	Exclude     []string
+}
+
+// Apply returns the solutions that pass the filter, in the same order.
+func (f SolutionFilter) Apply(solutions []Solution) []Solution {
+	mustInclude := upperAll(f.MustInclude)
+	exclude := upperAll(f.Exclude)
+	kept := []Solution{}
+
+	for _, sln := range solutions {
+		if f.Top > 0 && len(kept) >= f.Top {
+			break
+		}
+
+		if f.MaxWords > 0 && len(sln) > f.MaxWords {
+			continue
+		}
+
+		totalChars := sln.TotalChars()
+
+		if totalChars < f.MinTotalChars || (f.MaxTotalChars > 0 && totalChars > f.MaxTotalChars) {
+			continue
+		}
+
+		if !containsAll(sln, mustInclude) || containsAny(sln, exclude) {
+			continue
+		}
+
+		kept = append(kept, sln)
+	}
+
+	return kept
+}
+
+func upperAll(words []string) []string {
+	upper := make([]string, len(words))
+
+	for i, word := range words {
+		upper[i] = strings.ToUpper(word)
+	}
+
+	return upper
+}
+
+func containsAll(sln Solution, words []string) bool {
+	for _, word := range words {
+		if !slices.Contains(sln, word) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsAny(sln Solution, words []string) bool {
+	for _, word := range words {
+		if slices.Contains(sln, word) {
+			return true
+		}
+	}
+
+	return false
+}
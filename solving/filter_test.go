@@ -0,0 +1,57 @@
+package solving_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+func TestSolutionFilter(t *testing.T) {
+	slns := []solving.Solution{
+		{"PHANTOM", "MARIGOLD"},
+		{"HOLOGRAM", "MIDPOINT"},
+		{"PHANTOM", "MOLD", "DIRIGO"},
+	}
+
+	testCases := map[string]struct {
+		filter   solving.SolutionFilter
+		expected []solving.Solution
+	}{
+		"none": {
+			filter:   solving.SolutionFilter{},
+			expected: slns,
+		},
+		"top": {
+			filter:   solving.SolutionFilter{Top: 1},
+			expected: slns[:1],
+		},
+		"max words": {
+			filter:   solving.SolutionFilter{MaxWords: 2},
+			expected: slns[:2],
+		},
+		"total chars": {
+			filter:   solving.SolutionFilter{MinTotalChars: 16, MaxTotalChars: 16},
+			expected: slns[1:2],
+		},
+		"must include": {
+			filter:   solving.SolutionFilter{MustInclude: []string{"phantom", "mold"}},
+			expected: slns[2:],
+		},
+		"exclude": {
+			filter:   solving.SolutionFilter{Exclude: []string{"PHANTOM"}},
+			expected: slns[1:2],
+		},
+		"top after filtering": {
+			filter:   solving.SolutionFilter{Top: 1, MustInclude: []string{"PHANTOM"}, MinTotalChars: 17},
+			expected: slns[2:],
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.filter.Apply(slns))
+		})
+	}
+}
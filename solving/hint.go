@@ -0,0 +1,167 @@
+package solving
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+)
+
+type HintLevel int
+
+// Hint is one piece of information about the next word of a best solution
+// that continues the partial solution.
+type Hint struct {
+	Level   HintLevel `json:"level"`
+	Message string    `json:"message"`
+}
+
+// Hinter gives hints toward a best solution, which is the one with the
+// fewest words and then the fewest total characters.
+type Hinter struct {
+	puzzle *models.Puzzle
+	words  []string
+	dict   WordSet
+}
+
+const (
+	HintWordCount HintLevel = iota + 1
+	HintStartLetter
+	HintWordLength
+	HintWord
+
+	MaxHintLevel = HintWord
+)
+
+var (
+	errInvalidHintLevel = errors.New("invalid hint level")
+	errInvalidPartial   = errors.New("invalid partial solution")
+	errAlreadySolved    = errors.New("partial solution already solves the puzzle")
+	errNoCompletion     = errors.New("no solution continues the partial solution")
+)
+
+func NewHinter(p *models.Puzzle, words []string) *Hinter {
+	return &Hinter{
+		puzzle: p,
+		words:  words,
+		dict:   NewWordSet(words),
+	}
+}
+
+// Hints returns the hints up to and including the given level for the word
+// that follows the partial solution, which may be empty. Each level reveals
+// more than the one before it: whether a solution exists and how many words
+// it has, then the starting letter, length, and finally the word itself.
+func (h *Hinter) Hints(ctx context.Context, partial []string, level HintLevel) ([]Hint, error) {
+	if level < HintWordCount || level > MaxHintLevel {
+		return nil, fmt.Errorf("%w %d, must be in [%d, %d]",
+			errInvalidHintLevel, level, HintWordCount, MaxHintLevel)
+	}
+
+	partial, err := h.checkPartial(partial)
+	if err != nil {
+		return nil, err
+	}
+
+	best, err := h.bestCompletion(ctx, partial)
+	if err != nil {
+		return nil, err
+	}
+
+	next := best[len(partial)]
+	hints := []Hint{
+		{
+			Level:   HintWordCount,
+			Message: wordCountMessage(partial, best),
+		},
+		{
+			Level:   HintStartLetter,
+			Message: fmt.Sprintf("the next word starts with '%c'", []rune(next)[0]),
+		},
+		{
+			Level:   HintWordLength,
+			Message: fmt.Sprintf("the next word has %d letters", len([]rune(next))),
+		},
+		{
+			Level:   HintWord,
+			Message: fmt.Sprintf("the next word is %s", next),
+		},
+	}
+
+	return hints[:level], nil
+}
+
+// checkPartial returns the uppercased partial solution, or an error if
+// it breaks any rule other than leaving letters uncovered.
+func (h *Hinter) checkPartial(partial []string) (Solution, error) {
+	if len(partial) == 0 {
+		return Solution{}, nil
+	}
+
+	result := h.puzzle.CheckSolution(partial, h.dict)
+	if result.Valid {
+		return nil, errAlreadySolved
+	}
+
+	msgs := []string{}
+
+	for _, prob := range result.Problems {
+		if prob.Kind != models.ProblemUncoveredLetters {
+			msgs = append(msgs, prob.Message)
+		}
+	}
+
+	if len(msgs) > 0 {
+		return nil, fmt.Errorf("%w: %s", errInvalidPartial, strings.Join(msgs, "; "))
+	}
+
+	if len(result.Words) >= h.puzzle.GetMaxWords() {
+		return nil, errNoCompletion
+	}
+
+	return result.Words, nil
+}
+
+func (h *Hinter) bestCompletion(ctx context.Context, partial Solution) (Solution, error) {
+	solver := NewDPSolverFrom(h.puzzle, NewSliceWordSource(h.words), partial)
+
+	for !solver.IsFinished() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		solver.Step(ctx)
+	}
+
+	solutions := solver.GetSolutions()
+	if len(solutions) == 0 {
+		return nil, errNoCompletion
+	}
+
+	// sort first so that ties are broken the same way every time
+	solutions = slices.Clone(solutions)
+
+	slices.SortFunc(solutions, func(a, b Solution) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	best := solutions[0]
+	for _, sln := range solutions[1:] {
+		if sln.IsBetter(best) {
+			best = sln
+		}
+	}
+
+	return best, nil
+}
+
+func wordCountMessage(partial, best Solution) string {
+	if len(partial) == 0 {
+		return fmt.Sprintf("a %d-word solution exists", len(best))
+	}
+
+	return fmt.Sprintf("a %d-word solution continues from your words", len(best))
+}
@@ -0,0 +1,58 @@
+package solving_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+func TestHinterNoPartial(t *testing.T) {
+	h := newTestHinter()
+
+	hints, err := h.Hints(context.Background(), []string{}, solving.MaxHintLevel)
+
+	require.NoError(t, err)
+	require.Len(t, hints, 4)
+
+	assert.Equal(t, "a 2-word solution exists", hints[0].Message)
+	assert.Equal(t, "the next word starts with 'A'", hints[1].Message)
+	assert.Equal(t, "the next word has 8 letters", hints[2].Message)
+	assert.Equal(t, "the next word is ADGJBEHK", hints[3].Message)
+}
+
+func TestHinterPartial(t *testing.T) {
+	h := newTestHinter()
+
+	hints, err := h.Hints(context.Background(), []string{"adgjbehk"}, solving.HintWordLength)
+
+	require.NoError(t, err)
+	require.Len(t, hints, 3)
+
+	assert.Equal(t, solving.HintWordLength, hints[2].Level)
+	assert.Equal(t, "the next word has 5 letters", hints[2].Message)
+}
+
+func TestHinterErrors(t *testing.T) {
+	h := newTestHinter()
+	ctx := context.Background()
+
+	_, err := h.Hints(ctx, []string{}, 0)
+	assert.Error(t, err)
+
+	_, err = h.Hints(ctx, []string{"ADGJBEHK", "KCFIL"}, solving.HintWord)
+	assert.Error(t, err)
+
+	_, err = h.Hints(ctx, []string{"KCF", "FIL"}, solving.HintWord)
+	assert.Error(t, err)
+
+	_, err = h.Hints(ctx, []string{"XYZ"}, solving.HintWord)
+	assert.Error(t, err)
+}
+
+func newTestHinter() *solving.Hinter {
+	return solving.NewHinter(newTestPuzzle(), solving.ReadAllWords(newTestWordSource()))
+}
@@ -1,11 +1,20 @@
 package solving
 
-import "github.com/jamestunnell/letter-boxed-solver/models"
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+)
 
 type Scoring interface {
 	Score(models.LetterSet) float64
 }
 
+// ScoringFactory makes a scoring from the puzzle and its allowed words.
+type ScoringFactory func(p *models.Puzzle, infos []*WordInfo) Scoring
+
 type UniformScoring struct {
 }
 
@@ -13,7 +22,118 @@ type WeightedScoring struct {
 	LetterWeights map[rune]float64
 }
 
+const (
+	ScoringUniform          = "uniform"
+	ScoringWeighted         = "weighted"
+	ScoringInverseFrequency = "inverse-frequency"
+	ScoringEntropy          = "entropy"
+	ScoringHardSides        = "hard-sides"
+)
+
+var errUnknownScoring = errors.New("unknown scoring")
+
+var scoringFactories = map[string]ScoringFactory{
+	ScoringUniform: func(*models.Puzzle, []*WordInfo) Scoring {
+		return &UniformScoring{}
+	},
+	ScoringWeighted: func(_ *models.Puzzle, infos []*WordInfo) Scoring {
+		return NewWeightedScoring(infos)
+	},
+	ScoringInverseFrequency: func(_ *models.Puzzle, infos []*WordInfo) Scoring {
+		return NewInverseFrequencyScoring(infos)
+	},
+	ScoringEntropy: func(_ *models.Puzzle, infos []*WordInfo) Scoring {
+		return NewEntropyScoring(infos)
+	},
+	ScoringHardSides: NewHardSidesScoring,
+}
+
+// ScoringNames returns the names accepted by LookupScoring.
+func ScoringNames() []string {
+	return []string{
+		ScoringUniform,
+		ScoringWeighted,
+		ScoringInverseFrequency,
+		ScoringEntropy,
+		ScoringHardSides,
+	}
+}
+
+func LookupScoring(name string) (ScoringFactory, error) {
+	f, found := scoringFactories[name]
+	if !found {
+		return nil, fmt.Errorf("%w '%s'", errUnknownScoring, name)
+	}
+
+	return f, nil
+}
+
+// NewWeightedScoring weights each letter by its rarity, which is the
+// fraction of allowed words that do not contain the letter.
 func NewWeightedScoring(infos []*WordInfo) *WeightedScoring {
+	return newFrequencyScoring(infos, func(freq float64) float64 {
+		return 1.0 - freq
+	})
+}
+
+// NewInverseFrequencyScoring weights each letter by the inverse of the
+// fraction of allowed words that contain the letter.
+func NewInverseFrequencyScoring(infos []*WordInfo) *WeightedScoring {
+	return newFrequencyScoring(infos, func(freq float64) float64 {
+		return 1.0 / freq
+	})
+}
+
+// NewEntropyScoring weights each letter by its information content (in bits)
+// given the fraction of allowed words that contain the letter.
+func NewEntropyScoring(infos []*WordInfo) *WeightedScoring {
+	return newFrequencyScoring(infos, func(freq float64) float64 {
+		return -math.Log2(freq)
+	})
+}
+
+// NewHardSidesScoring weights each letter by the rarity of its side, so that
+// words covering letters on sides that few words visit score highest.
+func NewHardSidesScoring(p *models.Puzzle, infos []*WordInfo) Scoring {
+	freqs := letterFrequencies(infos)
+	weights := map[rune]float64{}
+
+	for _, side := range p.GetSides() {
+		sideFreq := 0.0
+		for _, r := range side {
+			sideFreq += freqs[r]
+		}
+
+		sideRarity := 1.0 - (sideFreq / float64(len(side)))
+
+		for _, r := range side {
+			weights[r] = sideRarity
+		}
+	}
+
+	return &WeightedScoring{
+		LetterWeights: weights,
+	}
+}
+
+func newFrequencyScoring(
+	infos []*WordInfo,
+	weight func(freq float64) float64,
+) *WeightedScoring {
+	weights := map[rune]float64{}
+
+	for r, freq := range letterFrequencies(infos) {
+		weights[r] = weight(freq)
+	}
+
+	return &WeightedScoring{
+		LetterWeights: weights,
+	}
+}
+
+// letterFrequencies returns the fraction of words that contain each letter.
+// Letters that are not in any word are omitted.
+func letterFrequencies(infos []*WordInfo) map[rune]float64 {
 	inWordsTotals := map[rune]int{}
 
 	for _, info := range infos {
@@ -23,14 +143,12 @@ func NewWeightedScoring(infos []*WordInfo) *WeightedScoring {
 	}
 
 	numWords := float64(len(infos))
-	weights := map[rune]float64{}
+	freqs := map[rune]float64{}
 	for r, total := range inWordsTotals {
-		weights[r] = float64(total) / numWords
+		freqs[r] = float64(total) / numWords
 	}
 
-	return &WeightedScoring{
-		LetterWeights: weights,
-	}
+	return freqs
 }
 
 func (s *UniformScoring) Score(ls models.LetterSet) float64 {
@@ -45,5 +163,5 @@ func (s *WeightedScoring) Score(ls models.LetterSet) float64 {
 		}
 	})
 
-	return float64(ls.Size())
+	return score
 }
@@ -0,0 +1,58 @@
+package solving_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+func TestWeightedScoringFavorsRareLetters(t *testing.T) {
+	infos := []*solving.WordInfo{
+		solving.NewWordInfo("ABC"),
+		solving.NewWordInfo("ABD"),
+		solving.NewWordInfo("ABE"),
+		solving.NewWordInfo("AXE"),
+	}
+
+	for _, name := range []string{
+		solving.ScoringWeighted,
+		solving.ScoringInverseFrequency,
+		solving.ScoringEntropy,
+	} {
+		newScoring, err := solving.LookupScoring(name)
+
+		require.NoError(t, err)
+
+		scoring := newScoring(nil, infos)
+		rare := scoring.Score(models.NewLetterSet("C"))
+		common := scoring.Score(models.NewLetterSet("B"))
+
+		assert.Greater(t, rare, common, name)
+	}
+}
+
+func TestHardSidesScoring(t *testing.T) {
+	p, err := models.NewPuzzle([]string{"abc", "xyz", "mno"}, 3)
+
+	require.NoError(t, err)
+
+	infos := []*solving.WordInfo{
+		solving.NewWordInfo("AXB"),
+		solving.NewWordInfo("BYC"),
+		solving.NewWordInfo("CXA"),
+		solving.NewWordInfo("AZB"),
+	}
+	scoring := solving.NewHardSidesScoring(p, infos)
+
+	assert.Greater(t, scoring.Score(models.NewLetterSet("Z")), scoring.Score(models.NewLetterSet("A")))
+}
+
+func TestLookupScoringUnknown(t *testing.T) {
+	_, err := solving.LookupScoring("bogus")
+
+	assert.Error(t, err)
+}
@@ -7,6 +7,8 @@ import (
 	"strings"
 
 	"golang.org/x/exp/maps"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
 )
 
 type Solution []string
@@ -88,6 +90,20 @@ func (s Solution) TotalChars() int {
 	return totalChars
 }
 
+// IsBetter returns true if the solution has fewer words than the other, or
+// the same number of words and fewer total characters.
+func (s Solution) IsBetter(other Solution) bool {
+	if len(s) != len(other) {
+		return len(s) < len(other)
+	}
+
+	return s.TotalChars() < other.TotalChars()
+}
+
+func (s Solution) Letters() models.LetterSet {
+	return models.NewLetterSet(s...)
+}
+
 func (s Solution) String() string {
 	return strings.Join(s, ", ")
 }
@@ -1,7 +1,12 @@
 package solving
 
 import (
+	"context"
+	"fmt"
+	"slices"
 	"sort"
+	"sync"
+	"time"
 
 	"github.com/rs/zerolog/log"
 
@@ -10,35 +15,81 @@ import (
 )
 
 type Solver struct {
-	puzzle    *models.Puzzle
-	explorers []*Explorer
-	scoring   Scoring
-	solutions []Solution
-	existing  map[uint64]struct{}
+	puzzle         *models.Puzzle
+	explorers      []*Explorer
+	explorersTotal int
+	singleWords    []Solution
+	scoring        Scoring
+	solutions      []Solution
+	existing       map[uint64]struct{}
+	workers        int
+	nodesExpanded  int
+	started        time.Time
+	constraints    Constraints
+}
+
+type SolverStats struct {
+	ExplorersTotal       int
+	ExplorersRemaining   int
+	NodesExpanded        int
+	SolutionsByWordCount map[int]int
+	Best                 Solution
+	Elapsed              time.Duration
 }
 
 func NewSolver(
 	p *models.Puzzle,
 	wordSource WordSource,
 	maxBranch int,
+	newScoring ScoringFactory,
 ) *Solver {
-	allowedWords := loadWords(wordSource, p.IsWordAllowed)
+	// with no constraints, there are no anchor words to be missing
+	s, _ := NewConstrainedSolver(p, wordSource, maxBranch, newScoring, Constraints{})
+
+	return s
+}
+
+// NewConstrainedSolver makes a solver that only finds solutions meeting the
+// constraints. Forbidden words are left out of the word graph. Since every
+// solution must use the required (and start and end) words, only the
+// explorers that start from those words are run, and they always branch to
+// the other required words. An error is returned if any of those words are
+// not in the word list.
+func NewConstrainedSolver(
+	p *models.Puzzle,
+	wordSource WordSource,
+	maxBranch int,
+	newScoring ScoringFactory,
+	constraints Constraints,
+) (*Solver, error) {
+	constraints = constraints.normalized()
+	allowedWords := loadWords(wordSource, func(word string) bool {
+		return p.IsWordAllowed(word) && !constraints.IsForbidden(word)
+	})
+
+	anchors := constraints.anchors()
+
+	for _, word := range anchors {
+		if !slices.Contains(allowedWords, word) {
+			return nil, fmt.Errorf("%w: %s is not in the word list", ErrInvalidConstraints, word)
+		}
+	}
 
 	log.Info().Msg("making word graph")
 
-	solutions := []Solution{}
+	singleWords := []Solution{}
 	unsolved := []*WordInfo{}
 	for _, word := range allowedWords {
 		info := NewWordInfo(word)
 		if p.DoLettersSolve(info.Letters) {
-			solutions = append(solutions, Solution{word})
+			singleWords = append(singleWords, Solution{word})
 		} else {
 			unsolved = append(unsolved, info)
 		}
 	}
 
 	wm := NewWordMapping(unsolved)
-	scoring := NewWeightedScoring(unsolved)
+	scoring := newScoring(p, unsolved)
 	sortByScoreAsc := &SortWordsByScoreAsc{
 		SortWordsByScore: &SortWordsByScore{
 			Infos: unsolved,
@@ -51,47 +102,157 @@ func NewSolver(
 	// sort so the best prospect is at the end
 	sort.Sort(sortByScoreAsc)
 
+	starts := unsolved
+	if len(anchors) > 0 {
+		starts = slices.DeleteFunc(slices.Clone(unsolved), func(info *WordInfo) bool {
+			return !slices.Contains(anchors, info.Word)
+		})
+	}
+
 	return &Solver{
 		puzzle: p,
-		explorers: util.Map(unsolved, func(info *WordInfo) *Explorer {
-			return NewExplorer(info, p, wm, maxBranch, scoring)
+		explorers: util.Map(starts, func(info *WordInfo) *Explorer {
+			e := NewExplorer(info, p, wm, maxBranch, scoring)
+
+			e.keepWords = anchors
+
+			return e
 		}),
-		scoring:   scoring,
-		solutions: solutions,
-		existing:  map[uint64]struct{}{},
-	}
+		explorersTotal: len(starts),
+		singleWords:    singleWords,
+		scoring:        scoring,
+		solutions:      []Solution{},
+		existing:       map[uint64]struct{}{},
+		workers:        1,
+		constraints:    constraints,
+	}, nil
+}
+
+// SetWorkers sets how many explorers are run in parallel by each step.
+func (s *Solver) SetWorkers(workers int) {
+	s.workers = max(workers, 1)
 }
 
 func (s *Solver) IsFinished() bool {
-	return len(s.explorers) == 0
+	return len(s.explorers) == 0 && len(s.singleWords) == 0
 }
 
 func (s *Solver) GetSolutions() []Solution {
 	return s.solutions
 }
 
-func (s *Solver) Step() {
+// Stats returns a snapshot of solving progress. Elapsed time is measured
+// from the first step.
+func (s *Solver) Stats() SolverStats {
+	stats := SolverStats{
+		ExplorersTotal:       s.explorersTotal,
+		ExplorersRemaining:   len(s.explorers),
+		NodesExpanded:        s.nodesExpanded,
+		SolutionsByWordCount: map[int]int{},
+	}
+
+	for _, sln := range s.solutions {
+		stats.SolutionsByWordCount[len(sln)]++
+
+		if stats.Best == nil || sln.IsBetter(stats.Best) {
+			stats.Best = sln
+		}
+	}
+
+	if !s.started.IsZero() {
+		stats.Elapsed = time.Since(s.started)
+	}
+
+	return stats
+}
+
+// Step explores around the next best start words. The first step also
+// returns the words that solve the puzzle on their own. If the context is
+// done, exploring stops early and the solutions found so far are kept, but
+// the start words are put back so the solver is not finished.
+func (s *Solver) Step(ctx context.Context) []FoundSolution {
+	if s.started.IsZero() {
+		s.started = time.Now()
+	}
+
+	found := []FoundSolution{}
+
+	for _, sln := range s.singleWords {
+		if s.addSolution(sln) {
+			found = append(found, FoundSolution{Solution: sln, StartWord: sln[0]})
+		}
+	}
+
+	s.singleWords = nil
+
 	remaining := len(s.explorers)
 
 	if remaining == 0 {
-		return
+		return found
 	}
 
-	e := s.explorers[remaining-1]
+	// pop the best prospects, which are at the end
+	n := min(s.workers, remaining)
+	popped := s.explorers[remaining-n:]
+	explorers := slices.Clone(popped)
+
+	slices.Reverse(explorers)
+
+	s.explorers = s.explorers[:remaining-n]
+
+	results := make([][]Solution, n)
+
+	if n == 1 {
+		results[0] = explorers[0].Explore(ctx)
+	} else {
+		var wg sync.WaitGroup
+
+		for i, e := range explorers {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
 
-	solutions := e.Explore()
-	for _, sln := range solutions {
-		hash := sln.Hash64()
-		if _, found := s.existing[hash]; found {
-			continue
+				results[i] = e.Explore(ctx)
+			}()
 		}
 
-		s.solutions = append(s.solutions, sln)
-		s.existing[hash] = struct{}{}
+		wg.Wait()
 	}
 
-	// pop
-	s.explorers = s.explorers[:remaining-1]
+	// explorers may have been cut short, so they still need to be run
+	if ctx.Err() != nil {
+		s.explorers = append(s.explorers, popped...)
+	}
+
+	// merge in best-first order
+	for i, solutions := range results {
+		s.nodesExpanded += explorers[i].NodesExpanded()
+
+		for _, sln := range solutions {
+			if s.addSolution(sln) {
+				found = append(found, FoundSolution{Solution: sln, StartWord: explorers[i].Word})
+			}
+		}
+	}
+
+	return found
+}
+
+func (s *Solver) addSolution(sln Solution) bool {
+	if !s.constraints.IsSatisfiedBy(sln) {
+		return false
+	}
+
+	hash := sln.Hash64()
+	if _, found := s.existing[hash]; found {
+		return false
+	}
+
+	s.solutions = append(s.solutions, sln)
+	s.existing[hash] = struct{}{}
+
+	return true
 }
 
 func loadWords(source WordSource, allowed func(string) bool) []string {
@@ -0,0 +1,191 @@
+package solving_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+func TestSolverWorkers(t *testing.T) {
+	serial := solving.NewSolver(newTestPuzzle(), newTestWordSource(), 5, newTestScoring)
+	parallel := solving.NewSolver(newTestPuzzle(), newTestWordSource(), 5, newTestScoring)
+
+	parallel.SetWorkers(4)
+
+	for !serial.IsFinished() {
+		serial.Step(context.Background())
+	}
+
+	for !parallel.IsFinished() {
+		parallel.Step(context.Background())
+	}
+
+	assert.NotEmpty(t, serial.GetSolutions())
+	assert.ElementsMatch(t, serial.GetSolutions(), parallel.GetSolutions())
+}
+
+func newTestScoring(p *models.Puzzle, infos []*solving.WordInfo) solving.Scoring {
+	return solving.NewWeightedScoring(infos)
+}
+
+func TestSolverStats(t *testing.T) {
+	s := solving.NewSolver(newTestPuzzle(), newTestWordSource(), 5, newTestScoring)
+
+	stats := s.Stats()
+
+	assert.Equal(t, 4, stats.ExplorersTotal)
+	assert.Equal(t, 4, stats.ExplorersRemaining)
+	assert.Zero(t, stats.NodesExpanded)
+	assert.Nil(t, stats.Best)
+
+	for !s.IsFinished() {
+		s.Step(context.Background())
+	}
+
+	stats = s.Stats()
+
+	assert.Zero(t, stats.ExplorersRemaining)
+	assert.Positive(t, stats.NodesExpanded)
+	assert.Equal(t, solving.Solution{"ADGJBEHK", "KCFIL"}, stats.Best)
+	assert.Equal(t, 1, stats.SolutionsByWordCount[2])
+	assert.Positive(t, stats.Elapsed)
+}
+
+// countdownContext is done after its Err method has been called a number
+// of times, so it can end partway through a step.
+type countdownContext struct {
+	context.Context
+
+	calls int
+}
+
+func (ctx *countdownContext) Err() error {
+	ctx.calls--
+
+	if ctx.calls < 0 {
+		return context.Canceled
+	}
+
+	return nil
+}
+
+func TestSolverStepCutShort(t *testing.T) {
+	s := solving.NewSolver(newTestPuzzle(), newTestWordSource(), 5, newTestScoring)
+	uncut := solving.NewSolver(newTestPuzzle(), newTestWordSource(), 5, newTestScoring)
+
+	for !uncut.IsFinished() {
+		uncut.Step(context.Background())
+	}
+
+	s.Step(&countdownContext{Context: context.Background(), calls: 2})
+
+	assert.False(t, s.IsFinished())
+	assert.Equal(t, 4, s.Stats().ExplorersRemaining)
+
+	for !s.IsFinished() {
+		s.Step(context.Background())
+	}
+
+	assert.ElementsMatch(t, uncut.GetSolutions(), s.GetSolutions())
+}
+
+func TestSolverSingleWord(t *testing.T) {
+	ws := &testWordSource{words: []string{"ADGJBEHKCFIL", "ADGJBEHK", "KCFIL"}}
+	s := solving.NewSolver(newTestPuzzle(), ws, 5, newTestScoring)
+
+	require.False(t, s.IsFinished())
+
+	found := s.Step(context.Background())
+
+	require.NotEmpty(t, found)
+	assert.Equal(t, solving.Solution{"ADGJBEHKCFIL"}, found[0].Solution)
+	assert.Equal(t, "ADGJBEHKCFIL", found[0].StartWord)
+
+	for !s.IsFinished() {
+		found = append(found, s.Step(context.Background())...)
+	}
+
+	assert.Len(t, found, len(s.GetSolutions()))
+	assert.Equal(t, 1, s.Stats().SolutionsByWordCount[1])
+}
+
+func TestConstrainedSolver(t *testing.T) {
+	testCases := map[string]struct {
+		constraints solving.Constraints
+		explorers   int
+		expected    []solving.Solution
+	}{
+		"forbidden": {
+			constraints: solving.Constraints{Forbidden: []string{"kcfil"}},
+			explorers:   3,
+			expected:    []solving.Solution{{"ADGJBEHK", "KCF", "FIL"}},
+		},
+		"required": {
+			constraints: solving.Constraints{Required: []string{"FIL"}},
+			explorers:   1,
+			expected:    []solving.Solution{{"ADGJBEHK", "KCF", "FIL"}},
+		},
+		"start and end": {
+			constraints: solving.Constraints{StartWord: "ADGJBEHK", EndWord: "KCFIL"},
+			explorers:   2,
+			expected:    []solving.Solution{{"ADGJBEHK", "KCFIL"}},
+		},
+		"unreachable": {
+			constraints: solving.Constraints{StartWord: "KCF"},
+			explorers:   1,
+			expected:    []solving.Solution{},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			s, err := solving.NewConstrainedSolver(newTestPuzzle(), newTestWordSource(), 5, newTestScoring, tc.constraints)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.explorers, s.Stats().ExplorersTotal)
+
+			for !s.IsFinished() {
+				s.Step(context.Background())
+			}
+
+			assert.ElementsMatch(t, tc.expected, s.GetSolutions())
+		})
+	}
+}
+
+func TestConstrainedSolverKeepsRequiredWords(t *testing.T) {
+	// with a max branch of 1, FBJAL beats FIL after KCF and BJCF beats KCF
+	// before FIL, so the required words are only found next to each other
+	// if they are kept
+	ws := &testWordSource{words: []string{"ADGJBEHK", "KCF", "FIL", "FBJAL", "BJCF"}}
+	constraints := solving.Constraints{Required: []string{"KCF", "FIL"}}
+	s, err := solving.NewConstrainedSolver(newTestPuzzle(), ws, 1, newTestScoring, constraints)
+	require.NoError(t, err)
+
+	for !s.IsFinished() {
+		s.Step(context.Background())
+	}
+
+	assert.Equal(t, []solving.Solution{{"ADGJBEHK", "KCF", "FIL"}}, s.GetSolutions())
+}
+
+func TestConstrainedSolverMissingWord(t *testing.T) {
+	testCases := map[string]solving.Constraints{
+		"required": {Required: []string{"KCFI"}},
+		"start":    {StartWord: "KCFI"},
+		"end":      {EndWord: "KCFI"},
+	}
+
+	for name, c := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := solving.NewConstrainedSolver(newTestPuzzle(), newTestWordSource(), 5, newTestScoring, c)
+
+			assert.ErrorIs(t, err, solving.ErrInvalidConstraints)
+		})
+	}
+}
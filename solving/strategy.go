@@ -0,0 +1,82 @@
+package solving
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+)
+
+// StrategyOptions selects and configures a solver.
+type StrategyOptions struct {
+	Strategy string
+	// MaxBranch, Workers, Scoring, and Constraints are only used by the
+	// best-first strategy.
+	MaxBranch   int
+	Workers     int
+	Scoring     string
+	Constraints Constraints
+}
+
+const (
+	StrategyBestFirst = "best-first"
+	StrategyExact     = "exact"
+	StrategyDP        = "dp"
+)
+
+var (
+	errUnknownStrategy        = errors.New("unknown solving strategy")
+	errConstraintsUnsupported = errors.New("constraints are only supported by the best-first strategy")
+)
+
+// StrategyNames returns the strategies accepted by NewStepper.
+func StrategyNames() []string {
+	return []string{StrategyBestFirst, StrategyExact, StrategyDP}
+}
+
+// DefaultStrategyOptions returns the options for best-first search with
+// weighted scoring.
+func DefaultStrategyOptions() StrategyOptions {
+	return StrategyOptions{
+		Strategy:  StrategyBestFirst,
+		MaxBranch: 5,
+		Workers:   1,
+		Scoring:   ScoringWeighted,
+	}
+}
+
+// NewStepper makes a solver for the puzzle using the strategy options.
+func NewStepper(p *models.Puzzle, wordSource WordSource, opts StrategyOptions) (Stepper, error) {
+	if !opts.Constraints.IsEmpty() {
+		if opts.Strategy != StrategyBestFirst {
+			return nil, fmt.Errorf("%w, not '%s'", errConstraintsUnsupported, opts.Strategy)
+		}
+
+		if err := opts.Constraints.Validate(p); err != nil {
+			return nil, err
+		}
+	}
+
+	switch opts.Strategy {
+	case StrategyBestFirst:
+		newScoring, err := LookupScoring(opts.Scoring)
+		if err != nil {
+			return nil, err
+		}
+
+		s, err := NewConstrainedSolver(p, wordSource, opts.MaxBranch, newScoring, opts.Constraints)
+		if err != nil {
+			return nil, err
+		}
+
+		s.SetWorkers(opts.Workers)
+
+		return s, nil
+	case StrategyExact:
+		return NewExactSolver(p, wordSource), nil
+	case StrategyDP:
+		return NewDPSolver(p, wordSource), nil
+	}
+
+	return nil, fmt.Errorf("%w '%s'", errUnknownStrategy, opts.Strategy)
+}
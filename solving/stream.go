@@ -0,0 +1,58 @@
+package solving
+
+import (
+	"context"
+	"iter"
+	"time"
+)
+
+type FoundSolution struct {
+	Solution  Solution
+	StartWord string
+	Elapsed   time.Duration
+}
+
+type Stepper interface {
+	IsFinished() bool
+	Step(ctx context.Context) []FoundSolution
+	GetSolutions() []Solution
+}
+
+// StreamOptions control progress reporting while streaming.
+type StreamOptions struct {
+	// ProgressInterval is the least time between progress reports. Zero
+	// means progress is not reported.
+	ProgressInterval time.Duration
+	// Progress is called between solver steps, at most once per interval,
+	// with the time elapsed since streaming began.
+	Progress func(elapsed time.Duration)
+}
+
+// Stream steps the solver until it is finished or the context is done,
+// yielding each new solution as soon as the step that found it returns.
+// Elapsed time is measured from when streaming began.
+func Stream(ctx context.Context, s Stepper, opts StreamOptions) iter.Seq[FoundSolution] {
+	return func(yield func(FoundSolution) bool) {
+		start := time.Now()
+		lastProgress := start
+
+		for !s.IsFinished() && ctx.Err() == nil {
+			for _, found := range s.Step(ctx) {
+				found.Elapsed = time.Since(start)
+
+				if !yield(found) {
+					return
+				}
+			}
+
+			if opts.Progress == nil || opts.ProgressInterval <= 0 ||
+				time.Since(lastProgress) < opts.ProgressInterval {
+				continue
+			}
+
+			opts.Progress(time.Since(start))
+
+			lastProgress = time.Now()
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package solving_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+func TestStream(t *testing.T) {
+	s := solving.NewExactSolver(newTestPuzzle(), newTestWordSource())
+	found := []solving.FoundSolution{}
+
+	for f := range solving.Stream(context.Background(), s, solving.StreamOptions{}) {
+		found = append(found, f)
+	}
+
+	require.Len(t, found, 1)
+	assert.Equal(t, solving.Solution{"ADGJBEHK", "KCFIL"}, found[0].Solution)
+	assert.Equal(t, "ADGJBEHK", found[0].StartWord)
+	assert.True(t, s.IsFinished())
+}
+
+func TestStreamStopsEarly(t *testing.T) {
+	s := solving.NewSolver(newTestPuzzle(), newTestWordSource(), 5, newTestScoring)
+
+	count := 0
+
+	for range solving.Stream(context.Background(), s, solving.StreamOptions{}) {
+		count++
+
+		break
+	}
+
+	assert.Equal(t, 1, count)
+	assert.False(t, s.IsFinished())
+}
+
+func TestStreamProgress(t *testing.T) {
+	s := solving.NewSolver(newTestPuzzle(), newTestWordSource(), 5, newTestScoring)
+	reports := 0
+
+	opts := solving.StreamOptions{
+		ProgressInterval: time.Nanosecond,
+		Progress: func(elapsed time.Duration) {
+			assert.Positive(t, elapsed)
+
+			reports++
+		},
+	}
+
+	for range solving.Stream(context.Background(), s, opts) {
+	}
+
+	assert.True(t, s.IsFinished())
+	assert.Equal(t, s.Stats().ExplorersTotal, reports)
+}
@@ -3,6 +3,7 @@ package solving
 import "slices"
 
 type WordMapping struct {
+	all           []*WordInfo
 	byFirstLetter map[rune][]*WordInfo
 	byLastLetter  map[rune][]*WordInfo
 }
@@ -17,6 +18,7 @@ func NewWordMapping(infos []*WordInfo) *WordMapping {
 	}
 
 	return &WordMapping{
+		all:           infos,
 		byFirstLetter: byFirstLetter,
 		byLastLetter:  byLastLetter,
 	}
@@ -29,3 +31,31 @@ func (wm *WordMapping) WordsWithFirstLetter(r rune) []*WordInfo {
 func (wm *WordMapping) WordsWithLastLetter(r rune) []*WordInfo {
 	return slices.Clone(wm.byLastLetter[r])
 }
+
+// AllWords returns every word in the mapping. The slice is shared, so it
+// must not be modified.
+func (wm *WordMapping) AllWords() []*WordInfo {
+	return wm.all
+}
+
+// NextWords returns the words that can follow the given word, which are
+// all words if chaining is not required. The slice is shared, so it must
+// not be modified.
+func (wm *WordMapping) NextWords(info *WordInfo, chaining bool) []*WordInfo {
+	if !chaining {
+		return wm.all
+	}
+
+	return wm.byFirstLetter[info.LastLetter]
+}
+
+// PrevWords returns the words that can precede the given word, which are
+// all words if chaining is not required. The slice is shared, so it must
+// not be modified.
+func (wm *WordMapping) PrevWords(info *WordInfo, chaining bool) []*WordInfo {
+	if !chaining {
+		return wm.all
+	}
+
+	return wm.byLastLetter[info.FirstLetter]
+}
@@ -0,0 +1,20 @@
+package solving
+
+// WordSet is a set of words, usable as a models.Dictionary.
+type WordSet map[string]struct{}
+
+func NewWordSet(words []string) WordSet {
+	ws := WordSet{}
+
+	for _, word := range words {
+		ws[word] = struct{}{}
+	}
+
+	return ws
+}
+
+func (ws WordSet) Contains(word string) bool {
+	_, found := ws[word]
+
+	return found
+}
@@ -3,6 +3,7 @@ package solving
 import (
 	"bufio"
 	"io/fs"
+	"strings"
 )
 
 type WordSource interface {
@@ -13,6 +14,27 @@ type FileWordSource struct {
 	scanner *bufio.Scanner
 }
 
+// SliceWordSource produces words from a slice, so a word list that was read
+// once can be reused by many solvers.
+type SliceWordSource struct {
+	words []string
+}
+
+// MultiWordSource merges words from several sources, in order, skipping
+// words that were already produced by an earlier source.
+type MultiWordSource struct {
+	sources []WordSource
+	seen    map[string]struct{}
+}
+
+// ExcludeWordSource produces the words from a source that are not excluded.
+type ExcludeWordSource struct {
+	source   WordSource
+	excluded map[string]struct{}
+}
+
+// NewFileWordSource makes a source that reads one word per line. Words are
+// trimmed and uppercased, and blank lines are skipped.
 func NewFileWordSource(f fs.File) WordSource {
 	scanner := bufio.NewScanner(f)
 
@@ -23,10 +45,98 @@ func NewFileWordSource(f fs.File) WordSource {
 	}
 }
 
+func NewSliceWordSource(words []string) WordSource {
+	return &SliceWordSource{words: words}
+}
+
+// ReadAllWords reads the remaining words from a source.
+func ReadAllWords(source WordSource) []string {
+	words := []string{}
+
+	word, ok := source.NextWord()
+	for ok {
+		words = append(words, word)
+
+		word, ok = source.NextWord()
+	}
+
+	return words
+}
+
+func NewMultiWordSource(sources ...WordSource) WordSource {
+	return &MultiWordSource{
+		sources: sources,
+		seen:    map[string]struct{}{},
+	}
+}
+
+// NewExcludeWordSource makes a source that skips all words from the
+// excluded source, which is read up front.
+func NewExcludeWordSource(source, excluded WordSource) WordSource {
+	excludedWords := map[string]struct{}{}
+
+	for _, word := range ReadAllWords(excluded) {
+		excludedWords[word] = struct{}{}
+	}
+
+	return &ExcludeWordSource{
+		source:   source,
+		excluded: excludedWords,
+	}
+}
+
 func (ws *FileWordSource) NextWord() (string, bool) {
-	if !ws.scanner.Scan() {
+	for ws.scanner.Scan() {
+		word := strings.ToUpper(strings.TrimSpace(ws.scanner.Text()))
+		if word != "" {
+			return word, true
+		}
+	}
+
+	return "", false
+}
+
+func (ws *SliceWordSource) NextWord() (string, bool) {
+	if len(ws.words) == 0 {
 		return "", false
 	}
 
-	return ws.scanner.Text(), true
+	word := ws.words[0]
+	ws.words = ws.words[1:]
+
+	return word, true
+}
+
+func (ws *MultiWordSource) NextWord() (string, bool) {
+	for len(ws.sources) > 0 {
+		word, ok := ws.sources[0].NextWord()
+		if !ok {
+			ws.sources = ws.sources[1:]
+
+			continue
+		}
+
+		if _, found := ws.seen[word]; found {
+			continue
+		}
+
+		ws.seen[word] = struct{}{}
+
+		return word, true
+	}
+
+	return "", false
+}
+
+func (ws *ExcludeWordSource) NextWord() (string, bool) {
+	word, ok := ws.source.NextWord()
+	for ok {
+		if _, found := ws.excluded[word]; !found {
+			return word, true
+		}
+
+		word, ok = ws.source.NextWord()
+	}
+
+	return "", false
 }
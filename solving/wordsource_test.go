@@ -0,0 +1,28 @@
+package solving_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+func TestMultiWordSource(t *testing.T) {
+	ws := solving.NewMultiWordSource(
+		&testWordSource{words: []string{"ABC", "DEF"}},
+		&testWordSource{words: []string{}},
+		&testWordSource{words: []string{"DEF", "GHI"}},
+	)
+
+	assert.Equal(t, []string{"ABC", "DEF", "GHI"}, solving.ReadAllWords(ws))
+}
+
+func TestExcludeWordSource(t *testing.T) {
+	ws := solving.NewExcludeWordSource(
+		&testWordSource{words: []string{"ABC", "DEF", "GHI"}},
+		&testWordSource{words: []string{"DEF", "XYZ"}},
+	)
+
+	assert.Equal(t, []string{"ABC", "GHI"}, solving.ReadAllWords(ws))
+}
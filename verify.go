@@ -0,0 +1,70 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/jamestunnell/letter-boxed-solver/models"
+	"github.com/jamestunnell/letter-boxed-solver/solving"
+)
+
+var errInvalidSolution = errors.New("solution is not valid")
+
+func verify(cmd *VerifyCmd) error {
+	puzzle, err := loadPuzzleCmd(cmd.PuzzleCmd)
+	if err != nil {
+		return err
+	}
+
+	dict, err := loadDictionary(cmd.WordsCmd)
+	if err != nil {
+		return err
+	}
+
+	result := puzzle.CheckSolution(cmd.Solution, dict)
+
+	if cmd.JSON {
+		enc := json.NewEncoder(os.Stdout)
+
+		enc.SetIndent("", "  ")
+
+		if err := enc.Encode(result); err != nil {
+			return err
+		}
+	} else {
+		printCheckResult(result)
+	}
+
+	if !result.Valid {
+		return errInvalidSolution
+	}
+
+	return nil
+}
+
+func loadDictionary(cmd WordsCmd) (solving.WordSet, error) {
+	wordSource, closeWords, err := openWordSource(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeWords()
+
+	return solving.NewWordSet(solving.ReadAllWords(wordSource)), nil
+}
+
+func printCheckResult(result *models.CheckResult) {
+	if result.Valid {
+		fmt.Printf("valid: %s\n", solving.Solution(result.Words))
+
+		return
+	}
+
+	fmt.Printf("not valid: %s\n", solving.Solution(result.Words))
+
+	for _, prob := range result.Problems {
+		fmt.Printf("  - %s\n", prob.Message)
+	}
+}